@@ -0,0 +1,85 @@
+package immutable
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// Comparer determines the order of values of type `T`.
+type Comparer[T any] interface {
+	// Compare returns a negative number if a is less than b, a positive number if a is
+	// greater than b, and zero if they are equal.
+	Compare(a T, b T) int
+}
+
+// ComparerFunc is an adapter allowing a function to be used as a `Comparer`.
+type ComparerFunc[T any] func(a T, b T) int
+
+var _ Comparer[any] = ComparerFunc[any](nil)
+
+// Compare calls f(a, b).
+func (f ComparerFunc[T]) Compare(a T, b T) int {
+	return f(a, b)
+}
+
+// DefaultComparer returns a `Comparer` for types with an underlying integer, float
+// or string type.
+//
+// The returned Comparer will panic if given values of any other type.
+func DefaultComparer[T any]() Comparer[T] {
+	return defaultComparer[T]{}
+}
+
+type defaultComparer[T any] struct{}
+
+var _ Comparer[any] = defaultComparer[any]{}
+
+func (defaultComparer[T]) Compare(a T, b T) int {
+	switch x := any(a).(type) {
+	case int:
+		return compareOrdered(x, any(b).(int))
+	case int64:
+		return compareOrdered(x, any(b).(int64))
+	case uint64:
+		return compareOrdered(x, any(b).(uint64))
+	case float64:
+		return compareOrdered(x, any(b).(float64))
+	case string:
+		return compareOrdered(x, any(b).(string))
+	default:
+		return compareValues(reflect.ValueOf(a), reflect.ValueOf(b))
+	}
+}
+
+func compareValues(a reflect.Value, b reflect.Value) int {
+	switch a.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return compareOrdered(a.Int(), b.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return compareOrdered(a.Uint(), b.Uint())
+	case reflect.Float32, reflect.Float64:
+		return compareOrdered(a.Float(), b.Float())
+	case reflect.String:
+		return compareOrdered(a.String(), b.String())
+	default:
+		panic(fmt.Sprintf("immutable: values of type %s cannot be compared", a.Type()))
+	}
+}
+
+type ordered interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64 |
+		~string
+}
+
+func compareOrdered[T ordered](a T, b T) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,155 @@
+package enumerable
+
+import "github.com/sourcenetwork/immutable"
+
+// Number is the set of types that `Sum` and `Average` may be performed over.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Ordered is the set of types that may be compared using the `<` operator.
+type Ordered interface {
+	Number | ~string
+}
+
+// Aggregate applies the given accumulator function to each item yielded by the given source,
+// passing the result of each call into the next, starting with the given seed. It returns the
+// result of the final call, or the seed if the source yields no items.
+//
+// It resets the source `Enumerable` on completion.
+func Aggregate[T any, TAccumulate any](
+	source Enumerable[T],
+	seed TAccumulate,
+	accumulator func(TAccumulate, T) (TAccumulate, error),
+) (TAccumulate, error) {
+	acc := seed
+	for {
+		hasNext, err := source.Next()
+		if err != nil {
+			return acc, err
+		}
+		if !hasNext {
+			break
+		}
+		item, err := source.Value()
+		if err != nil {
+			return acc, err
+		}
+		acc, err = accumulator(acc, item)
+		if err != nil {
+			return acc, err
+		}
+	}
+	source.Reset()
+	return acc, nil
+}
+
+// Count returns the number of items yielded by the given source.
+//
+// It resets the source `Enumerable` on completion.
+func Count[T any](source Enumerable[T]) (int, error) {
+	count := 0
+	err := OnEach(source, func() {
+		count++
+	})
+	return count, err
+}
+
+// Sum returns the sum of the items yielded by the given source, or zero if no items are
+// yielded.
+//
+// It resets the source `Enumerable` on completion.
+func Sum[T Number](source Enumerable[T]) (T, error) {
+	return Aggregate(source, 0, func(acc T, item T) (T, error) {
+		return acc + item, nil
+	})
+}
+
+// Average returns the mean of the items yielded by the given source, or no value if no items
+// are yielded.
+//
+// It resets the source `Enumerable` on completion.
+func Average[T Number](source Enumerable[T]) (immutable.Option[float64], error) {
+	var sum float64
+	count := 0
+	err := ForEach(source, func(item T) {
+		sum += float64(item)
+		count++
+	})
+	if err != nil || count == 0 {
+		return immutable.None[float64](), err
+	}
+	return immutable.Some(sum / float64(count)), nil
+}
+
+// Min returns the smallest item yielded by the given source, or no value if no items are
+// yielded. If several items are equally small, the first is returned.
+//
+// It resets the source `Enumerable` on completion.
+func Min[T Ordered](source Enumerable[T]) (immutable.Option[T], error) {
+	return MinBy(source, func(item T) (T, error) {
+		return item, nil
+	})
+}
+
+// Max returns the largest item yielded by the given source, or no value if no items are
+// yielded. If several items are equally large, the first is returned.
+//
+// It resets the source `Enumerable` on completion.
+func Max[T Ordered](source Enumerable[T]) (immutable.Option[T], error) {
+	return MaxBy(source, func(item T) (T, error) {
+		return item, nil
+	})
+}
+
+// MinBy returns the item yielded by the given source with the smallest key, as returned by
+// the given key selector, or no value if no items are yielded. If several items have equally
+// small keys, the first is returned.
+//
+// It resets the source `Enumerable` on completion.
+func MinBy[T any, K Ordered](source Enumerable[T], keySelector func(T) (K, error)) (immutable.Option[T], error) {
+	return extremeBy(source, keySelector, func(a K, b K) bool {
+		return a < b
+	})
+}
+
+// MaxBy returns the item yielded by the given source with the largest key, as returned by
+// the given key selector, or no value if no items are yielded. If several items have equally
+// large keys, the first is returned.
+//
+// It resets the source `Enumerable` on completion.
+func MaxBy[T any, K Ordered](source Enumerable[T], keySelector func(T) (K, error)) (immutable.Option[T], error) {
+	return extremeBy(source, keySelector, func(a K, b K) bool {
+		return a > b
+	})
+}
+
+// extremeBy returns the first item yielded by the given source whose key is not bettered by
+// that of any other item, where `better` returns true if the first key is preferred over the
+// second.
+func extremeBy[T any, K any](
+	source Enumerable[T],
+	keySelector func(T) (K, error),
+	better func(K, K) bool,
+) (immutable.Option[T], error) {
+	best, err := Aggregate(
+		source,
+		immutable.None[immutable.Pair[T, K]](),
+		func(best immutable.Option[immutable.Pair[T, K]], item T) (immutable.Option[immutable.Pair[T, K]], error) {
+			key, err := keySelector(item)
+			if err != nil {
+				return best, err
+			}
+			if !best.HasValue() || better(key, best.Value().Second()) {
+				return immutable.Some(immutable.NewPair(item, key)), nil
+			}
+			return best, nil
+		},
+	)
+	if err != nil || !best.HasValue() {
+		return immutable.None[T](), err
+	}
+	return immutable.Some(best.Value().First()), nil
+}
@@ -0,0 +1,109 @@
+package enumerable
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAggregateYieldsAccumulatedResult(t *testing.T) {
+	source := New([]string{"a", "b", "c"})
+
+	result, err := Aggregate(source, ">", func(acc string, item string) (string, error) {
+		return acc + item, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, ">abc", result)
+
+	// The source should have been reset
+	require.Equal(t, []string{"a", "b", "c"}, collect(t, source))
+}
+
+func TestAggregateYieldsSeedGivenEmptySource(t *testing.T) {
+	result, err := Aggregate(New([]int{}), 5, func(acc int, item int) (int, error) {
+		return acc + item, nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, 5, result)
+}
+
+func TestAggregateReturnsErrorGivenAccumulatorError(t *testing.T) {
+	expectedErr := errors.New("test error")
+
+	_, err := Aggregate(New([]int{1}), 0, func(acc int, item int) (int, error) {
+		return 0, expectedErr
+	})
+	require.ErrorIs(t, err, expectedErr)
+}
+
+func TestCountYieldsNumberOfItems(t *testing.T) {
+	count, err := Count(New([]int{4, 5, 6}))
+	require.NoError(t, err)
+	require.Equal(t, 3, count)
+}
+
+func TestSumYieldsTotal(t *testing.T) {
+	sum, err := Sum(New([]float64{1.5, 2, 3}))
+	require.NoError(t, err)
+	require.Equal(t, 6.5, sum)
+}
+
+func TestAverageYieldsMean(t *testing.T) {
+	average, err := Average(New([]int{1, 2, 4}))
+	require.NoError(t, err)
+	require.Equal(t, immutable.Some(7.0/3.0), average)
+}
+
+func TestAverageYieldsNoneGivenEmptySource(t *testing.T) {
+	average, err := Average(New([]int{}))
+	require.NoError(t, err)
+	require.False(t, average.HasValue())
+}
+
+func TestMinAndMaxYieldExtremes(t *testing.T) {
+	source := New([]int{3, 1, 4, 1, 5})
+
+	minimum, err := Min(source)
+	require.NoError(t, err)
+	require.Equal(t, immutable.Some(1), minimum)
+
+	maximum, err := Max(source)
+	require.NoError(t, err)
+	require.Equal(t, immutable.Some(5), maximum)
+}
+
+func TestMinAndMaxYieldNoneGivenEmptySource(t *testing.T) {
+	minimum, err := Min(New([]string{}))
+	require.NoError(t, err)
+	require.False(t, minimum.HasValue())
+
+	maximum, err := Max(New([]string{}))
+	require.NoError(t, err)
+	require.False(t, maximum.HasValue())
+}
+
+func TestMinByAndMaxByYieldFirstItemWithExtremeKey(t *testing.T) {
+	source := New([]string{"bb", "a", "cc", "d"})
+	length := func(s string) (int, error) {
+		return len(s), nil
+	}
+
+	minimum, err := MinBy(source, length)
+	require.NoError(t, err)
+	require.Equal(t, immutable.Some("a"), minimum)
+
+	maximum, err := MaxBy(source, length)
+	require.NoError(t, err)
+	require.Equal(t, immutable.Some("bb"), maximum)
+}
+
+func TestMinByReturnsErrorGivenKeySelectorError(t *testing.T) {
+	expectedErr := errors.New("test error")
+
+	_, err := MinBy(New([]int{1}), func(v int) (int, error) {
+		return 0, expectedErr
+	})
+	require.ErrorIs(t, err, expectedErr)
+}
@@ -0,0 +1,239 @@
+package enumerable
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// ErrQueueClosed is returned when attempting to add items to a closed queue.
+var ErrQueueClosed = errors.New("queue is closed")
+
+// BlockingQueue is a `Queue` that is safe for concurrent use, where `Next` blocks until
+// an item is available or the queue is closed.
+//
+// `Next` and `Value` are not atomic, if multiple goroutines consume from the queue they
+// should use `Take` instead.
+type BlockingQueue[T any] interface {
+	Queue[T]
+
+	// TryPut adds an item to the queue without blocking, returning false if the queue
+	// is full.
+	TryPut(T) (bool, error)
+
+	// PutContext adds an item to the queue, blocking until there is space for it or
+	// the given context is done.
+	PutContext(context.Context, T) error
+
+	// TryNext behaves like `Next`, but returns false instead of blocking if the queue
+	// is empty.
+	TryNext() (bool, error)
+
+	// NextContext behaves like `Next`, but will return the given context's error if it
+	// is done before an item is available.
+	NextContext(context.Context) (bool, error)
+
+	// Take removes and returns the next item from the queue, blocking until an item is
+	// available or the queue has been closed and drained, in which case false is returned.
+	//
+	// If the given context is done before an item is available its error will be returned.
+	Take(context.Context) (T, bool, error)
+
+	// Len returns the number of items in the queue.
+	Len() int
+
+	// Close closes the queue. Items already in the queue may still be consumed, after
+	// which `Next` will return false instead of blocking. Putting items into a closed
+	// queue will return `ErrQueueClosed`.
+	Close()
+}
+
+type blockingQueue[T any] struct {
+	mutex sync.Mutex
+
+	values Queue[T]
+
+	// The number of items in the queue.
+	count int
+
+	// The maximum number of items the queue may hold, unbounded if less than one.
+	capacity int
+
+	closed bool
+
+	// Closed and replaced whenever the state of the queue changes, waking any
+	// goroutines waiting on it.
+	changed chan struct{}
+
+	currentValue T
+}
+
+var _ BlockingQueue[any] = (*blockingQueue[any])(nil)
+
+// NewBlockingQueue creates an empty FIFO queue that is safe for concurrent use.
+//
+// If capacity is greater than zero, `Put` will block whilst the queue holds that many
+// items, otherwise the queue is unbounded.
+func NewBlockingQueue[T any](capacity int) BlockingQueue[T] {
+	return &blockingQueue[T]{
+		values:   NewQueue[T](),
+		capacity: capacity,
+		changed:  make(chan struct{}),
+	}
+}
+
+func (q *blockingQueue[T]) Put(value T) error {
+	_, err := q.put(context.Background(), value, true)
+	return err
+}
+
+func (q *blockingQueue[T]) TryPut(value T) (bool, error) {
+	return q.put(context.Background(), value, false)
+}
+
+func (q *blockingQueue[T]) PutContext(ctx context.Context, value T) error {
+	_, err := q.put(ctx, value, true)
+	return err
+}
+
+func (q *blockingQueue[T]) Next() (bool, error) {
+	return q.NextContext(context.Background())
+}
+
+func (q *blockingQueue[T]) TryNext() (bool, error) {
+	return q.next(context.Background(), false)
+}
+
+func (q *blockingQueue[T]) NextContext(ctx context.Context) (bool, error) {
+	return q.next(ctx, true)
+}
+
+func (q *blockingQueue[T]) Take(ctx context.Context) (T, bool, error) {
+	return q.take(ctx, true)
+}
+
+func (q *blockingQueue[T]) Value() (T, error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return q.currentValue, nil
+}
+
+// Reset discards all items in the queue. It does not reopen a closed queue.
+func (q *blockingQueue[T]) Reset() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.values.Reset()
+	q.count = 0
+	q.notify()
+}
+
+func (q *blockingQueue[T]) Size() int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return q.values.Size()
+}
+
+func (q *blockingQueue[T]) Len() int {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	return q.count
+}
+
+func (q *blockingQueue[T]) Close() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if !q.closed {
+		q.closed = true
+		q.notify()
+	}
+}
+
+// notify wakes all goroutines waiting for the state of the queue to change.
+//
+// The mutex must be held when calling this function.
+func (q *blockingQueue[T]) notify() {
+	close(q.changed)
+	q.changed = make(chan struct{})
+}
+
+// wait releases the mutex and blocks until the state of the queue changes or the given
+// context is done. The mutex will be held on return if no error is returned.
+func (q *blockingQueue[T]) wait(ctx context.Context) error {
+	changed := q.changed
+	q.mutex.Unlock()
+	select {
+	case <-changed:
+		q.mutex.Lock()
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (q *blockingQueue[T]) put(ctx context.Context, value T, block bool) (bool, error) {
+	q.mutex.Lock()
+	for {
+		if q.closed {
+			q.mutex.Unlock()
+			return false, ErrQueueClosed
+		}
+		if q.capacity < 1 || q.count < q.capacity {
+			break
+		}
+		if !block {
+			q.mutex.Unlock()
+			return false, nil
+		}
+		if err := q.wait(ctx); err != nil {
+			return false, err
+		}
+	}
+	defer q.mutex.Unlock()
+
+	err := q.values.Put(value)
+	if err != nil {
+		return false, err
+	}
+	q.count++
+	q.notify()
+	return true, nil
+}
+
+func (q *blockingQueue[T]) next(ctx context.Context, block bool) (bool, error) {
+	value, hasValue, err := q.take(ctx, block)
+	if !hasValue || err != nil {
+		return hasValue, err
+	}
+
+	q.mutex.Lock()
+	q.currentValue = value
+	q.mutex.Unlock()
+	return true, nil
+}
+
+func (q *blockingQueue[T]) take(ctx context.Context, block bool) (T, bool, error) {
+	var zero T
+	q.mutex.Lock()
+	for q.count == 0 {
+		if q.closed || !block {
+			q.mutex.Unlock()
+			return zero, false, nil
+		}
+		if err := q.wait(ctx); err != nil {
+			return zero, false, err
+		}
+	}
+	defer q.mutex.Unlock()
+
+	hasValue, err := q.values.Next()
+	if !hasValue || err != nil {
+		return zero, hasValue, err
+	}
+	value, err := q.values.Value()
+	if err != nil {
+		return zero, false, err
+	}
+	q.count--
+	q.notify()
+	return value, true, nil
+}
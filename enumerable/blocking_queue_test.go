@@ -0,0 +1,188 @@
+package enumerable
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBlockingQueueYieldsItemsInOrder(t *testing.T) {
+	queue := NewBlockingQueue[int](0)
+
+	for i := 1; i <= 3; i++ {
+		err := queue.Put(i)
+		require.NoError(t, err)
+	}
+	queue.Close()
+
+	results := []int{}
+	err := ForEach[int](queue, func(v int) {
+		results = append(results, v)
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, results)
+}
+
+func TestBlockingQueueNextBlocksUntilPut(t *testing.T) {
+	queue := NewBlockingQueue[int](0)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		queue.Put(1)
+	}()
+
+	hasNext, err := queue.Next()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+
+	v, err := queue.Value()
+	require.NoError(t, err)
+	require.Equal(t, 1, v)
+}
+
+func TestBlockingQueueNextUnblocksGivenClose(t *testing.T) {
+	queue := NewBlockingQueue[int](0)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		queue.Close()
+	}()
+
+	hasNext, err := queue.Next()
+	require.NoError(t, err)
+	require.False(t, hasNext)
+}
+
+func TestBlockingQueuePutReturnsErrorGivenClosed(t *testing.T) {
+	queue := NewBlockingQueue[int](0)
+	queue.Close()
+
+	err := queue.Put(1)
+	require.ErrorIs(t, err, ErrQueueClosed)
+
+	_, err = queue.TryPut(1)
+	require.ErrorIs(t, err, ErrQueueClosed)
+}
+
+func TestBlockingQueueTryNextReturnsFalseGivenEmpty(t *testing.T) {
+	queue := NewBlockingQueue[int](0)
+
+	hasNext, err := queue.TryNext()
+	require.NoError(t, err)
+	require.False(t, hasNext)
+
+	queue.Put(1)
+
+	hasNext, err = queue.TryNext()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+}
+
+func TestBlockingQueueTryPutReturnsFalseGivenFull(t *testing.T) {
+	queue := NewBlockingQueue[int](1)
+
+	added, err := queue.TryPut(1)
+	require.NoError(t, err)
+	require.True(t, added)
+
+	added, err = queue.TryPut(2)
+	require.NoError(t, err)
+	require.False(t, added)
+	require.Equal(t, 1, queue.Len())
+}
+
+func TestBlockingQueuePutBlocksUntilSpace(t *testing.T) {
+	queue := NewBlockingQueue[int](1)
+	queue.Put(1)
+
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		queue.TryNext()
+	}()
+
+	err := queue.Put(2)
+	require.NoError(t, err)
+
+	v, hasNext, err := queue.Take(context.Background())
+	require.NoError(t, err)
+	require.True(t, hasNext)
+	require.Equal(t, 2, v)
+}
+
+func TestBlockingQueueContextWaitsReturnErrorGivenCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	queue := NewBlockingQueue[int](1)
+
+	hasNext, err := queue.NextContext(ctx)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.False(t, hasNext)
+
+	queue.Put(1)
+	err = queue.PutContext(ctx, 2)
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestBlockingQueueResetDiscardsItems(t *testing.T) {
+	queue := NewBlockingQueue[int](0)
+	queue.Put(1)
+	queue.Reset()
+
+	require.Equal(t, 0, queue.Len())
+	hasNext, err := queue.TryNext()
+	require.NoError(t, err)
+	require.False(t, hasNext)
+}
+
+func TestBlockingQueueGivenManyProducersAndConsumers(t *testing.T) {
+	const producers = 8
+	const consumers = 8
+	const itemsPerProducer = 1_000
+	queue := NewBlockingQueue[int](16)
+
+	var producerGroup sync.WaitGroup
+	for p := 0; p < producers; p++ {
+		producerGroup.Add(1)
+		go func(p int) {
+			defer producerGroup.Done()
+			for i := 0; i < itemsPerProducer; i++ {
+				err := queue.Put(p*itemsPerProducer + i)
+				require.NoError(t, err)
+			}
+		}(p)
+	}
+
+	results := make(chan []int, consumers)
+	for c := 0; c < consumers; c++ {
+		go func() {
+			consumed := []int{}
+			for {
+				v, hasValue, err := queue.Take(context.Background())
+				require.NoError(t, err)
+				if !hasValue {
+					break
+				}
+				consumed = append(consumed, v)
+			}
+			results <- consumed
+		}()
+	}
+
+	producerGroup.Wait()
+	queue.Close()
+
+	all := []int{}
+	for c := 0; c < consumers; c++ {
+		all = append(all, <-results...)
+	}
+	sort.Ints(all)
+
+	require.Len(t, all, producers*itemsPerProducer)
+	for i, v := range all {
+		require.Equal(t, i, v)
+	}
+}
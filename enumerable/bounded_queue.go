@@ -0,0 +1,138 @@
+package enumerable
+
+import "fmt"
+
+// OverflowPolicy determines how a bounded queue behaves when an item is put into it
+// whilst it is full.
+type OverflowPolicy int
+
+const (
+	// OverflowError causes `Put` to return a `QueueFullError`, the new item is discarded.
+	OverflowError OverflowPolicy = iota
+	// OverflowDropOldest causes the oldest item in the queue to be discarded to make space
+	// for the new item.
+	OverflowDropOldest
+	// OverflowDropNewest causes the new item to be discarded.
+	OverflowDropNewest
+	// OverflowBlock causes `Put` to block until another goroutine makes space in the queue.
+	OverflowBlock
+)
+
+// QueueFullError is returned when putting an item into a full queue with the
+// `OverflowError` policy.
+type QueueFullError struct {
+	// The capacity of the queue.
+	Capacity int
+}
+
+func (e *QueueFullError) Error() string {
+	return fmt.Sprintf("queue is full, capacity: %d", e.Capacity)
+}
+
+type boundedQueue[T any] struct {
+	values   Queue[T]
+	capacity int
+	policy   OverflowPolicy
+
+	// The current value is held separately from the values queue, as the DropOldest
+	// policy may move the values queue past it.
+	currentValue T
+}
+
+var _ Queue[any] = (*boundedQueue[any])(nil)
+
+// NewBoundedQueue creates an empty FIFO queue that holds at most the given number of
+// items, using the given policy when items are put into it whilst it is full.
+//
+// Queues using the `OverflowBlock` policy are created by `NewBlockingBoundedQueue` and are
+// safe for concurrent use, queues using any other policy are not. Use
+// `NewBlockingBoundedQueue` directly to access the `BlockingQueue` methods. It will panic if
+// capacity is less than one.
+func NewBoundedQueue[T any](capacity int, policy OverflowPolicy) Queue[T] {
+	if capacity < 1 {
+		panic(fmt.Sprintf("enumerable: bounded queue capacity must be positive, got %d", capacity))
+	}
+	if policy == OverflowBlock {
+		return NewBlockingBoundedQueue[T](capacity)
+	}
+	return &boundedQueue[T]{
+		values:   NewQueue[T](),
+		capacity: capacity,
+		policy:   policy,
+	}
+}
+
+func (q *boundedQueue[T]) Put(value T) error {
+	if q.values.Len() >= q.capacity {
+		switch q.policy {
+		case OverflowDropNewest:
+			return nil
+		case OverflowDropOldest:
+			_, err := q.values.Next()
+			if err != nil {
+				return err
+			}
+		default:
+			return &QueueFullError{Capacity: q.capacity}
+		}
+	}
+	return q.values.Put(value)
+}
+
+func (q *boundedQueue[T]) Next() (bool, error) {
+	hasNext, err := q.values.Next()
+	if !hasNext || err != nil {
+		return hasNext, err
+	}
+
+	value, err := q.values.Value()
+	if err != nil {
+		return false, err
+	}
+	q.currentValue = value
+	return true, nil
+}
+
+func (q *boundedQueue[T]) Value() (T, error) {
+	return q.currentValue, nil
+}
+
+func (q *boundedQueue[T]) Reset() {
+	q.values.Reset()
+}
+
+func (q *boundedQueue[T]) Size() int {
+	return q.values.Size()
+}
+
+func (q *boundedQueue[T]) Len() int {
+	return q.values.Len()
+}
+
+// NewBlockingBoundedQueue creates an empty FIFO queue that is safe for concurrent use and
+// holds at most the given number of items, with `Put` blocking whilst it is full.
+//
+// Unlike other `BlockingQueue`s `Next` does not block, returning false when the queue is
+// empty like any other `Queue`. Use `NextContext` or `Take` to wait for items, and `Close`
+// to end the wait once no more items will be put. It will panic if capacity is less than
+// one.
+func NewBlockingBoundedQueue[T any](capacity int) BlockingQueue[T] {
+	if capacity < 1 {
+		panic(fmt.Sprintf("enumerable: bounded queue capacity must be positive, got %d", capacity))
+	}
+	return &blockingBoundedQueue[T]{
+		BlockingQueue: NewBlockingQueue[T](capacity),
+	}
+}
+
+// blockingBoundedQueue is a `BlockingQueue` whose `Next` does not block, so that the
+// `OverflowBlock` policy only affects producers.
+type blockingBoundedQueue[T any] struct {
+	BlockingQueue[T]
+}
+
+var _ BlockingQueue[any] = (*blockingBoundedQueue[any])(nil)
+
+func (q *blockingBoundedQueue[T]) Next() (bool, error) {
+	return q.TryNext()
+}
@@ -0,0 +1,148 @@
+package enumerable
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func drainQueue[T any](t *testing.T, queue Queue[T]) []T {
+	results := []T{}
+	for {
+		hasNext, err := queue.Next()
+		require.NoError(t, err)
+		if !hasNext {
+			return results
+		}
+		v, err := queue.Value()
+		require.NoError(t, err)
+		results = append(results, v)
+	}
+}
+
+func TestBoundedQueueReturnsErrorGivenFull(t *testing.T) {
+	queue := NewBoundedQueue[int](2, OverflowError)
+
+	require.NoError(t, queue.Put(1))
+	require.NoError(t, queue.Put(2))
+
+	err := queue.Put(3)
+	var fullErr *QueueFullError
+	require.True(t, errors.As(err, &fullErr))
+	require.Equal(t, 2, fullErr.Capacity)
+
+	require.Equal(t, []int{1, 2}, drainQueue(t, queue))
+}
+
+func TestBoundedQueueDropsOldestGivenFull(t *testing.T) {
+	queue := NewBoundedQueue[int](2, OverflowDropOldest)
+
+	for i := 1; i <= 5; i++ {
+		require.NoError(t, queue.Put(i))
+	}
+
+	require.Equal(t, 2, queue.Len())
+	require.Equal(t, []int{4, 5}, drainQueue(t, queue))
+}
+
+func TestBoundedQueueDropOldestDoesNotAffectCurrentValue(t *testing.T) {
+	queue := NewBoundedQueue[int](1, OverflowDropOldest)
+	queue.Put(1)
+
+	hasNext, err := queue.Next()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+
+	queue.Put(2)
+	queue.Put(3)
+
+	v, err := queue.Value()
+	require.NoError(t, err)
+	require.Equal(t, 1, v)
+
+	require.Equal(t, []int{3}, drainQueue(t, queue))
+}
+
+func TestBoundedQueueDropsNewestGivenFull(t *testing.T) {
+	queue := NewBoundedQueue[int](2, OverflowDropNewest)
+
+	for i := 1; i <= 5; i++ {
+		require.NoError(t, queue.Put(i))
+	}
+
+	require.Equal(t, 2, queue.Len())
+	require.Equal(t, []int{1, 2}, drainQueue(t, queue))
+}
+
+func TestBoundedQueueAcceptsItemsAfterDrain(t *testing.T) {
+	queue := NewBoundedQueue[int](2, OverflowError)
+
+	require.NoError(t, queue.Put(1))
+	require.NoError(t, queue.Put(2))
+	require.Equal(t, []int{1, 2}, drainQueue(t, queue))
+
+	require.NoError(t, queue.Put(3))
+	require.NoError(t, queue.Put(4))
+	require.Equal(t, []int{3, 4}, drainQueue(t, queue))
+}
+
+func TestBoundedQueueBlocksGivenBlockPolicy(t *testing.T) {
+	queue := NewBoundedQueue[int](1, OverflowBlock)
+
+	blockingQueue, ok := queue.(BlockingQueue[int])
+	require.True(t, ok)
+
+	require.NoError(t, queue.Put(1))
+	added, err := blockingQueue.TryPut(2)
+	require.NoError(t, err)
+	require.False(t, added)
+}
+
+func TestBlockingBoundedQueueWaitsForItemsUntilClosed(t *testing.T) {
+	queue := NewBlockingBoundedQueue[int](1)
+
+	go func() {
+		for i := 1; i <= 3; i++ {
+			require.NoError(t, queue.Put(i))
+		}
+		queue.Close()
+	}()
+
+	results := []int{}
+	for {
+		v, ok, err := queue.Take(context.Background())
+		require.NoError(t, err)
+		if !ok {
+			break
+		}
+		results = append(results, v)
+	}
+	require.Equal(t, []int{1, 2, 3}, results)
+}
+
+func TestBoundedQueueDrainsWithoutBlockingGivenBlockPolicy(t *testing.T) {
+	queue := NewBoundedQueue[int](2, OverflowBlock)
+
+	require.NoError(t, queue.Put(1))
+	require.NoError(t, queue.Put(2))
+	require.Equal(t, []int{1, 2}, drainQueue(t, queue))
+
+	require.NoError(t, queue.Put(3))
+	results := []int{}
+	err := ForEach[int](queue, func(v int) {
+		results = append(results, v)
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{3}, results)
+}
+
+func TestBoundedQueuePanicsGivenInvalidCapacity(t *testing.T) {
+	require.Panics(t, func() {
+		NewBoundedQueue[int](0, OverflowError)
+	})
+	require.Panics(t, func() {
+		NewBlockingBoundedQueue[int](0)
+	})
+}
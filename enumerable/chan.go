@@ -0,0 +1,99 @@
+package enumerable
+
+import "context"
+
+type enumerableChan[T any] struct {
+	ctx          context.Context
+	source       <-chan T
+	currentValue T
+}
+
+// FromChan creates an `Enumerable` that yields the items received from the given channel
+// until it is closed.
+//
+// `Next` will block until an item is received, the channel is closed, or the given context
+// is done, in which case the context's error will be returned.
+//
+// Items received from a channel cannot be received again, as such `Reset` does nothing and
+// enumeration will continue from the channel's current position.
+func FromChan[T any](ctx context.Context, source <-chan T) Enumerable[T] {
+	return &enumerableChan[T]{
+		ctx:    ctx,
+		source: source,
+	}
+}
+
+func (s *enumerableChan[T]) Next() (bool, error) {
+	// Check the context first, as select chooses randomly between ready cases.
+	if err := s.ctx.Err(); err != nil {
+		return false, err
+	}
+
+	select {
+	case value, ok := <-s.source:
+		if !ok {
+			return false, nil
+		}
+		s.currentValue = value
+		return true, nil
+	case <-s.ctx.Done():
+		return false, s.ctx.Err()
+	}
+}
+
+func (s *enumerableChan[T]) Value() (T, error) {
+	return s.currentValue, nil
+}
+
+func (s *enumerableChan[T]) Reset() {}
+
+// ToChan enumerates the given source on a new goroutine, sending each item yielded to the
+// returned values channel.
+//
+// Both returned channels are closed once enumeration ends. If an error is generated during
+// enumeration, or the given context is done before enumeration completes, the error will be
+// sent to the returned error channel, which is buffered so that it does not need to be read.
+// The source is reset on successful completion.
+//
+// The source must not be used elsewhere until the values channel has been closed.
+func ToChan[T any](ctx context.Context, source Enumerable[T]) (<-chan T, <-chan error) {
+	values := make(chan T)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(values)
+		defer close(errs)
+
+		for {
+			if err := ctx.Err(); err != nil {
+				errs <- err
+				return
+			}
+
+			hasNext, err := source.Next()
+			if err != nil {
+				errs <- err
+				return
+			}
+			if !hasNext {
+				break
+			}
+
+			value, err := source.Value()
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			select {
+			case values <- value:
+			case <-ctx.Done():
+				errs <- ctx.Err()
+				return
+			}
+		}
+		source.Reset()
+	}()
+
+	return values, errs
+}
@@ -0,0 +1,109 @@
+package enumerable
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromChanYieldsItemsUntilClosed(t *testing.T) {
+	source := make(chan int)
+	go func() {
+		for i := 1; i <= 3; i++ {
+			source <- i
+		}
+		close(source)
+	}()
+
+	results := []int{}
+	err := ForEach(FromChan(context.Background(), source), func(v int) {
+		results = append(results, v)
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, results)
+}
+
+func TestFromChanReturnsErrorGivenCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	source := make(chan int, 1)
+	enumerable := FromChan(ctx, source)
+
+	source <- 1
+	hasNext, err := enumerable.Next()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+
+	cancel()
+	// Buffered items should not be yielded once the context is done
+	source <- 2
+	hasNext, err = enumerable.Next()
+	require.ErrorIs(t, err, context.Canceled)
+	require.False(t, hasNext)
+}
+
+func TestFromChanContinuesGivenReset(t *testing.T) {
+	source := make(chan int, 2)
+	source <- 1
+	source <- 2
+	close(source)
+	enumerable := FromChan(context.Background(), source)
+
+	hasNext, err := enumerable.Next()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+
+	enumerable.Reset()
+
+	hasNext, err = enumerable.Next()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+
+	v, err := enumerable.Value()
+	require.NoError(t, err)
+	require.Equal(t, 2, v)
+}
+
+func TestToChanSendsItems(t *testing.T) {
+	values, errs := ToChan(context.Background(), New([]int{1, 2, 3}))
+
+	results := []int{}
+	for v := range values {
+		results = append(results, v)
+	}
+	require.Equal(t, []int{1, 2, 3}, results)
+	require.NoError(t, <-errs)
+}
+
+func TestToChanSendsError(t *testing.T) {
+	expectedErr := errors.New("test error")
+	source := Where(New([]int{1, 2, 3}), func(v int) (bool, error) {
+		if v == 2 {
+			return false, expectedErr
+		}
+		return true, nil
+	})
+	values, errs := ToChan(context.Background(), source)
+
+	results := []int{}
+	for v := range values {
+		results = append(results, v)
+	}
+	require.Equal(t, []int{1}, results)
+	require.ErrorIs(t, <-errs, expectedErr)
+}
+
+func TestToChanSendsErrorGivenCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	values, errs := ToChan(ctx, New([]int{1, 2, 3}))
+
+	v := <-values
+	require.Equal(t, 1, v)
+
+	cancel()
+	require.ErrorIs(t, <-errs, context.Canceled)
+
+	_, ok := <-values
+	require.False(t, ok)
+}
@@ -1,5 +1,7 @@
 package enumerable
 
+import "context"
+
 // Concatenation is an extention of the enumerable interface allowing new sources
 // to be added after initial construction.
 type Concatenation[T any] interface {
@@ -80,3 +82,85 @@ func (s *enumerableConcat[T]) Reset() {
 		source.Reset()
 	}
 }
+
+// ContextConcatenation is the `ContextEnumerable` equivalent of `Concatenation`.
+type ContextConcatenation[T any] interface {
+	ContextEnumerable[T]
+	// Append appends a new source to this concatenation.
+	//
+	// This may be done after enumeration has begun.
+	Append(ContextEnumerable[T])
+}
+
+type contextConcat[T any] struct {
+	sources            []ContextEnumerable[T]
+	currentSourceIndex int
+}
+
+// ConcatContext is the `ContextEnumerable` equivalent of `Concat`.
+//
+// The context is checked before each source is evaluated.
+func ConcatContext[T any](sources ...ContextEnumerable[T]) ContextConcatenation[T] {
+	return &contextConcat[T]{
+		sources:            sources,
+		currentSourceIndex: 0,
+	}
+}
+
+// Append appends a new source to this concatenation.
+//
+// This may be done after enumeration has begun.
+func (s *contextConcat[T]) Append(newSource ContextEnumerable[T]) {
+	s.sources = append(s.sources, newSource)
+}
+
+func (s *contextConcat[T]) Next(ctx context.Context) (bool, error) {
+	startSourceIndex := s.currentSourceIndex
+	hasLooped := false
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+
+		// If we have reached the end of the sources slice we need to loop
+		// back to the beginning.  It may be that earlier sources have gained
+		// items whilst we iterated though later sources.
+		if s.currentSourceIndex >= len(s.sources) {
+			if len(s.sources) < 1 || hasLooped {
+				return false, nil
+			}
+			s.currentSourceIndex = 0
+			hasLooped = true
+		}
+
+		currentSource := s.sources[s.currentSourceIndex]
+		hasValue, err := currentSource.Next(ctx)
+		if err != nil {
+			return false, err
+		}
+		if hasValue {
+			return true, nil
+		}
+
+		s.currentSourceIndex += 1
+
+		if s.currentSourceIndex == startSourceIndex {
+			// If we are here it means that we have re-cycled
+			// all the way through the source slice and have found
+			// no new items.
+			return false, nil
+		}
+	}
+}
+
+func (s *contextConcat[T]) Value() (T, error) {
+	return s.sources[s.currentSourceIndex].Value()
+}
+
+func (s *contextConcat[T]) Reset() {
+	s.currentSourceIndex = 0
+	for _, source := range s.sources {
+		source.Reset()
+	}
+}
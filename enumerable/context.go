@@ -0,0 +1,127 @@
+package enumerable
+
+import "context"
+
+// ContextEnumerable represents a set of elements that can be iterated through multiple
+// times, where each step of the iteration may be cancelled via a `context.Context`.
+//
+// It mirrors `Enumerable`, and the two can be converted between using `AsContextEnumerable`
+// and `BindContext`.
+type ContextEnumerable[T any] interface {
+	// Next attempts to evaluate the next item in the enumeration - allowing its
+	// exposure via the `Value()` function.
+	//
+	// It will return false if it has reached the end of the enumerable, and/or an
+	// error if one was generated during evaluation. If the given context is done
+	// before the next item has been evaluated, the context's error will be returned.
+	Next(ctx context.Context) (bool, error)
+
+	// Value returns the current item in the enumeration. It does not progress the
+	// enumeration, and should be a simple getter.
+	//
+	// If the previous Next call did not return true, or Next has never been called
+	// the behaviour and return value of this function is undefined.
+	Value() (T, error)
+
+	// Reset resets the enumerable, allowing for re-iteration.
+	Reset()
+}
+
+type contextAware[T any] struct {
+	source Enumerable[T]
+}
+
+// AsContextEnumerable creates a `ContextEnumerable` from the given `Enumerable`.
+//
+// The context is checked before each call to the source's `Next`, but cannot interrupt
+// a call that is in progress.
+func AsContextEnumerable[T any](source Enumerable[T]) ContextEnumerable[T] {
+	return &contextAware[T]{
+		source: source,
+	}
+}
+
+func (s *contextAware[T]) Next(ctx context.Context) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	return s.source.Next()
+}
+
+func (s *contextAware[T]) Value() (T, error) {
+	return s.source.Value()
+}
+
+func (s *contextAware[T]) Reset() {
+	s.source.Reset()
+}
+
+type contextBound[T any] struct {
+	ctx    context.Context
+	source ContextEnumerable[T]
+}
+
+// BindContext creates an `Enumerable` from the given `ContextEnumerable`, passing the
+// given context to each `Next` call.
+func BindContext[T any](ctx context.Context, source ContextEnumerable[T]) Enumerable[T] {
+	return &contextBound[T]{
+		ctx:    ctx,
+		source: source,
+	}
+}
+
+func (s *contextBound[T]) Next() (bool, error) {
+	return s.source.Next(s.ctx)
+}
+
+func (s *contextBound[T]) Value() (T, error) {
+	return s.source.Value()
+}
+
+func (s *contextBound[T]) Reset() {
+	s.source.Reset()
+}
+
+// ForEachContext iterates over the given source `ContextEnumerable` performing the given
+// action on each item. It resets the source `ContextEnumerable` on completion.
+//
+// If the given context is done before iteration completes, the context's error will be
+// returned.
+func ForEachContext[T any](ctx context.Context, source ContextEnumerable[T], action func(item T)) error {
+	for {
+		hasNext, err := source.Next(ctx)
+		if err != nil {
+			return err
+		}
+		if !hasNext {
+			break
+		}
+		item, err := source.Value()
+		if err != nil {
+			return err
+		}
+		action(item)
+	}
+	source.Reset()
+	return nil
+}
+
+// OnEachContext iterates over the given source `ContextEnumerable` performing the given
+// action for each item yielded. It resets the source `ContextEnumerable` on completion.
+//
+// If the given context is done before iteration completes, the context's error will be
+// returned.
+func OnEachContext[T any](ctx context.Context, source ContextEnumerable[T], action func()) error {
+	for {
+		hasNext, err := source.Next(ctx)
+		if err != nil {
+			return err
+		}
+		if !hasNext {
+			break
+		}
+		action()
+	}
+	source.Reset()
+	return nil
+}
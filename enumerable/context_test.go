@@ -0,0 +1,136 @@
+package enumerable
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// cancellingSource returns a `ContextEnumerable` over the given items that cancels the
+// returned context once the given number of items have been yielded.
+func cancellingSource(items []int, cancelAfter int) (ContextEnumerable[int], context.Context) {
+	ctx, cancel := context.WithCancel(context.Background())
+	count := 0
+	source := Select(New(items), func(v int) (int, error) {
+		count++
+		if count == cancelAfter {
+			cancel()
+		}
+		return v, nil
+	})
+	return AsContextEnumerable(source), ctx
+}
+
+func collectContext[T any](ctx context.Context, source ContextEnumerable[T]) ([]T, error) {
+	results := []T{}
+	err := ForEachContext(ctx, source, func(v T) {
+		results = append(results, v)
+	})
+	return results, err
+}
+
+func TestContextOperatorsYieldItems(t *testing.T) {
+	source := AsContextEnumerable(New([]int{5, 4, 3, 2, 1, 6}))
+
+	isEven := func(v int) (bool, error) { return v%2 == 0, nil }
+	double := func(v int) (int, error) { return v * 2, nil }
+	less := func(a, b int) bool { return a < b }
+
+	composed := TakeContext(
+		SkipContext(
+			SortContext(
+				SelectContext(WhereContext(source, isEven), double),
+				less,
+				6,
+			),
+			1,
+		),
+		1,
+	)
+
+	results, err := collectContext(context.Background(), composed)
+	require.NoError(t, err)
+	require.Equal(t, []int{8}, results)
+}
+
+func TestWhereContextReturnsErrorGivenCancelledContext(t *testing.T) {
+	source, ctx := cancellingSource([]int{1, 2, 3}, 1)
+
+	results, err := collectContext(ctx, WhereContext(source, func(v int) (bool, error) {
+		return v > 1, nil
+	}))
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, []int{}, results)
+}
+
+func TestSelectContextReturnsErrorGivenCancelledContext(t *testing.T) {
+	source, ctx := cancellingSource([]int{1, 2, 3}, 2)
+
+	results, err := collectContext(ctx, SelectContext(source, func(v int) (int, error) {
+		return v, nil
+	}))
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, []int{1, 2}, results)
+}
+
+func TestSkipContextReturnsErrorGivenCancelledContext(t *testing.T) {
+	source, ctx := cancellingSource([]int{1, 2, 3}, 1)
+
+	results, err := collectContext(ctx, SkipContext(source, 2))
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, []int{}, results)
+}
+
+func TestTakeContextReturnsErrorGivenCancelledContext(t *testing.T) {
+	source, ctx := cancellingSource([]int{1, 2, 3}, 1)
+
+	results, err := collectContext(ctx, TakeContext(source, 2))
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, []int{1}, results)
+}
+
+func TestSortContextReturnsErrorGivenCancelledContext(t *testing.T) {
+	source, ctx := cancellingSource([]int{3, 2, 1}, 2)
+
+	results, err := collectContext(ctx, SortContext(source, func(a, b int) bool { return a < b }, 3))
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, []int{}, results)
+}
+
+func TestConcatContextReturnsErrorGivenCancelledContext(t *testing.T) {
+	source, ctx := cancellingSource([]int{1, 2}, 2)
+
+	results, err := collectContext[int](ctx, ConcatContext(AsContextEnumerable(New([]int{0})), source))
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, []int{0, 1, 2}, results)
+}
+
+func TestConcatContextYieldsAppendedItems(t *testing.T) {
+	concat := ConcatContext[int]()
+	concat.Append(AsContextEnumerable(New([]int{1, 2})))
+
+	results, err := collectContext[int](context.Background(), concat)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2}, results)
+}
+
+func TestOnEachContextReturnsErrorGivenCancelledContext(t *testing.T) {
+	source, ctx := cancellingSource([]int{1, 2, 3}, 1)
+
+	count := 0
+	err := OnEachContext(ctx, source, func() { count++ })
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 1, count)
+}
+
+func TestBindContextYieldsItems(t *testing.T) {
+	source := BindContext(context.Background(), AsContextEnumerable(New([]int{1, 2})))
+
+	results := []int{}
+	err := ForEach(source, func(v int) {
+		results = append(results, v)
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2}, results)
+}
@@ -0,0 +1,192 @@
+package enumerable
+
+import "github.com/sourcenetwork/immutable"
+
+// Deque is a double-ended queue, allowing items to be added and removed at both ends.
+//
+// Unlike `Queue`, it is not itself an `Enumerable`. Instead it provides enumerable views
+// over its items that do not remove them.
+type Deque[T any] interface {
+	// PushFront adds an item to the front of the deque.
+	PushFront(T)
+	// PushBack adds an item to the back of the deque.
+	PushBack(T)
+	// PopFront removes and returns the item at the front of the deque, or no value if
+	// the deque is empty.
+	PopFront() immutable.Option[T]
+	// PopBack removes and returns the item at the back of the deque, or no value if
+	// the deque is empty.
+	PopBack() immutable.Option[T]
+	// PeekFront returns the item at the front of the deque without removing it, or no
+	// value if the deque is empty.
+	PeekFront() immutable.Option[T]
+	// PeekBack returns the item at the back of the deque without removing it, or no
+	// value if the deque is empty.
+	PeekBack() immutable.Option[T]
+	// Get returns the item at the given index, counting from the front of the deque, or
+	// no value if the index is out of range.
+	Get(index int) immutable.Option[T]
+	// Len returns the number of items in the deque.
+	Len() int
+	// Enumerable returns an `Enumerable` that yields the items in the deque from front
+	// to back, without removing them.
+	//
+	// Items are yielded by position, if the deque is modified during enumeration the
+	// items yielded will reflect its state at the time of each `Next` call.
+	Enumerable() Enumerable[T]
+	// Reverse returns an `Enumerable` that yields the items in the deque from back to
+	// front, without removing them.
+	//
+	// Items are yielded by position, if the deque is modified during enumeration the
+	// items yielded will reflect its state at the time of each `Next` call.
+	Reverse() Enumerable[T]
+}
+
+type deque[T any] struct {
+	// The values slice of this deque.
+	//
+	// Note: deque is implementated as a ring buffer, the zero index is not nessecarily
+	// the front value.
+	values []T
+
+	// The index of the front value.
+	headIndex int
+
+	// The number of values in the deque.
+	count int
+}
+
+var _ Deque[any] = (*deque[any])(nil)
+
+// NewDeque creates an empty double-ended queue.
+//
+// It is implemented using a dynamically sized ring-buffer, that grows geometrically
+// as items are added.
+func NewDeque[T any]() Deque[T] {
+	return &deque[T]{
+		values: []T{},
+	}
+}
+
+func (d *deque[T]) PushFront(value T) {
+	d.growIfFull()
+	d.headIndex = (d.headIndex - 1 + len(d.values)) % len(d.values)
+	d.values[d.headIndex] = value
+	d.count += 1
+}
+
+func (d *deque[T]) PushBack(value T) {
+	d.growIfFull()
+	d.values[d.index(d.count)] = value
+	d.count += 1
+}
+
+func (d *deque[T]) PopFront() immutable.Option[T] {
+	if d.count == 0 {
+		return immutable.None[T]()
+	}
+	value := d.values[d.headIndex]
+	// Clear the slot so that the deque does not hold a reference to the value.
+	var zero T
+	d.values[d.headIndex] = zero
+	d.headIndex = (d.headIndex + 1) % len(d.values)
+	d.count -= 1
+	return immutable.Some(value)
+}
+
+func (d *deque[T]) PopBack() immutable.Option[T] {
+	if d.count == 0 {
+		return immutable.None[T]()
+	}
+	index := d.index(d.count - 1)
+	value := d.values[index]
+	var zero T
+	d.values[index] = zero
+	d.count -= 1
+	return immutable.Some(value)
+}
+
+func (d *deque[T]) PeekFront() immutable.Option[T] {
+	return d.Get(0)
+}
+
+func (d *deque[T]) PeekBack() immutable.Option[T] {
+	return d.Get(d.count - 1)
+}
+
+func (d *deque[T]) Get(index int) immutable.Option[T] {
+	if index < 0 || index >= d.count {
+		return immutable.None[T]()
+	}
+	return immutable.Some(d.values[d.index(index)])
+}
+
+func (d *deque[T]) Len() int {
+	return d.count
+}
+
+func (d *deque[T]) Enumerable() Enumerable[T] {
+	return &dequeEnumerable[T]{
+		source:       d,
+		currentIndex: -1,
+	}
+}
+
+func (d *deque[T]) Reverse() Enumerable[T] {
+	return &dequeEnumerable[T]{
+		source:       d,
+		currentIndex: -1,
+		reverse:      true,
+	}
+}
+
+// index returns the index within the values slice of the item at the given position,
+// counting from the front of the deque.
+func (d *deque[T]) index(position int) int {
+	return (d.headIndex + position) % len(d.values)
+}
+
+func (d *deque[T]) growIfFull() {
+	if d.count < len(d.values) {
+		return
+	}
+
+	newLength := len(d.values) * growthFactor
+	if newLength == 0 {
+		newLength = 1
+	}
+	newValues := make([]T, newLength)
+	n := copy(newValues, d.values[d.headIndex:])
+	copy(newValues[n:], d.values[:d.headIndex])
+	d.values = newValues
+	d.headIndex = 0
+}
+
+type dequeEnumerable[T any] struct {
+	source       *deque[T]
+	currentIndex int
+	reverse      bool
+	currentValue T
+}
+
+func (s *dequeEnumerable[T]) Next() (bool, error) {
+	if s.currentIndex+1 >= s.source.Len() {
+		return false, nil
+	}
+	s.currentIndex += 1
+
+	position := s.currentIndex
+	if s.reverse {
+		position = s.source.Len() - 1 - s.currentIndex
+	}
+	s.currentValue = s.source.Get(position).Value()
+	return true, nil
+}
+
+func (s *dequeEnumerable[T]) Value() (T, error) {
+	return s.currentValue, nil
+}
+
+func (s *dequeEnumerable[T]) Reset() {
+	s.currentIndex = -1
+}
@@ -0,0 +1,82 @@
+package enumerable
+
+import (
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+)
+
+func collect[T any](t *testing.T, source Enumerable[T]) []T {
+	results := []T{}
+	err := ForEach(source, func(v T) {
+		results = append(results, v)
+	})
+	require.NoError(t, err)
+	return results
+}
+
+func TestDequeYieldsNothingGivenEmpty(t *testing.T) {
+	deque := NewDeque[int]()
+
+	require.Equal(t, immutable.None[int](), deque.PopFront())
+	require.Equal(t, immutable.None[int](), deque.PopBack())
+	require.Equal(t, immutable.None[int](), deque.PeekFront())
+	require.Equal(t, immutable.None[int](), deque.PeekBack())
+	require.Equal(t, []int{}, collect(t, deque.Enumerable()))
+}
+
+func TestDequePushAndPopAtBothEnds(t *testing.T) {
+	deque := NewDeque[int]()
+
+	deque.PushBack(2)
+	deque.PushBack(3)
+	deque.PushFront(1)
+	deque.PushFront(0)
+	deque.PushBack(4)
+
+	require.Equal(t, 5, deque.Len())
+	require.Equal(t, []int{0, 1, 2, 3, 4}, collect(t, deque.Enumerable()))
+	require.Equal(t, []int{4, 3, 2, 1, 0}, collect(t, deque.Reverse()))
+	require.Equal(t, immutable.Some(0), deque.PeekFront())
+	require.Equal(t, immutable.Some(4), deque.PeekBack())
+	require.Equal(t, immutable.Some(2), deque.Get(2))
+	require.Equal(t, immutable.None[int](), deque.Get(5))
+
+	require.Equal(t, immutable.Some(0), deque.PopFront())
+	require.Equal(t, immutable.Some(4), deque.PopBack())
+	require.Equal(t, immutable.Some(3), deque.PopBack())
+	require.Equal(t, []int{1, 2}, collect(t, deque.Enumerable()))
+}
+
+func TestDequeYieldsItemsGivenManyWrappedPushes(t *testing.T) {
+	deque := NewDeque[int]()
+	expected := []int{}
+
+	for i := 0; i < 1_000; i++ {
+		switch i % 4 {
+		case 0:
+			deque.PushFront(i)
+			expected = append([]int{i}, expected...)
+		case 1, 2:
+			deque.PushBack(i)
+			expected = append(expected, i)
+		case 3:
+			v := deque.PopFront()
+			require.Equal(t, immutable.Some(expected[0]), v)
+			expected = expected[1:]
+		}
+	}
+
+	require.Equal(t, expected, collect(t, deque.Enumerable()))
+}
+
+func TestDequeEnumerableDoesNotRemoveItems(t *testing.T) {
+	deque := NewDeque[int]()
+	deque.PushBack(1)
+	deque.PushBack(2)
+
+	require.Equal(t, []int{1, 2}, collect(t, deque.Enumerable()))
+	require.Equal(t, []int{1, 2}, collect(t, deque.Enumerable()))
+	require.Equal(t, 2, deque.Len())
+}
@@ -0,0 +1,163 @@
+package enumerable
+
+type enumerableDistinct[T any, K comparable] struct {
+	source      Enumerable[T]
+	keySelector func(T) (K, error)
+
+	// The keys of the items yielded so far.
+	seen map[K]struct{}
+}
+
+// Distinct creates an `Enumerable` that yields the items of the given source, skipping any
+// item equal to one already yielded.
+//
+// Items are yielded in the order they are first seen in the source. The set of seen items
+// grows as the source is enumerated, and is cleared on `Reset`.
+func Distinct[T comparable](source Enumerable[T]) Enumerable[T] {
+	return DistinctBy(source, func(item T) (T, error) {
+		return item, nil
+	})
+}
+
+// DistinctBy creates an `Enumerable` that yields the items of the given source, skipping any
+// item whose key, as returned by the given key selector, matches that of an item already
+// yielded.
+//
+// Items are yielded in the order they are first seen in the source. The set of seen keys
+// grows as the source is enumerated, and is cleared on `Reset`.
+func DistinctBy[T any, K comparable](source Enumerable[T], keySelector func(T) (K, error)) Enumerable[T] {
+	return &enumerableDistinct[T, K]{
+		source:      source,
+		keySelector: keySelector,
+		seen:        map[K]struct{}{},
+	}
+}
+
+func (s *enumerableDistinct[T, K]) Next() (bool, error) {
+	for {
+		hasNext, err := s.source.Next()
+		if !hasNext || err != nil {
+			return hasNext, err
+		}
+
+		value, err := s.source.Value()
+		if err != nil {
+			return false, err
+		}
+
+		key, err := s.keySelector(value)
+		if err != nil {
+			return false, err
+		}
+
+		if _, ok := s.seen[key]; !ok {
+			s.seen[key] = struct{}{}
+			return true, nil
+		}
+	}
+}
+
+func (s *enumerableDistinct[T, K]) Value() (T, error) {
+	return s.source.Value()
+}
+
+func (s *enumerableDistinct[T, K]) Reset() {
+	s.seen = map[K]struct{}{}
+	s.source.Reset()
+}
+
+// Union creates an `Enumerable` that yields the distinct items of the first source followed
+// by those of the second source that were not in the first.
+//
+// Both sources are streamed, and the set of seen items is cleared on `Reset`.
+func Union[T comparable](first Enumerable[T], second Enumerable[T]) Enumerable[T] {
+	return Distinct[T](Concat(first, second))
+}
+
+type enumerableSetFilter[T comparable] struct {
+	first  Enumerable[T]
+	second Enumerable[T]
+
+	// If true items found in the second source are yielded, otherwise items not found in
+	// the second source are yielded.
+	include bool
+
+	// The items of the second source, read on the first call to `Next`.
+	lookup map[T]struct{}
+
+	// The items yielded so far.
+	seen map[T]struct{}
+}
+
+// Intersect creates an `Enumerable` that yields the distinct items of the first source that
+// are also found in the second source, in the order they are first seen in the first source.
+//
+// The second source is read in full on the first call to `Next`, the first source is
+// streamed. Both sources are reset, and the buffered items cleared, on `Reset`.
+func Intersect[T comparable](first Enumerable[T], second Enumerable[T]) Enumerable[T] {
+	return &enumerableSetFilter[T]{
+		first:   first,
+		second:  second,
+		include: true,
+		seen:    map[T]struct{}{},
+	}
+}
+
+// Except creates an `Enumerable` that yields the distinct items of the first source that
+// are not found in the second source, in the order they are first seen in the first source.
+//
+// The second source is read in full on the first call to `Next`, the first source is
+// streamed. Both sources are reset, and the buffered items cleared, on `Reset`.
+func Except[T comparable](first Enumerable[T], second Enumerable[T]) Enumerable[T] {
+	return &enumerableSetFilter[T]{
+		first:   first,
+		second:  second,
+		include: false,
+		seen:    map[T]struct{}{},
+	}
+}
+
+func (s *enumerableSetFilter[T]) Next() (bool, error) {
+	if s.lookup == nil {
+		lookup := map[T]struct{}{}
+		err := ForEach(s.second, func(item T) {
+			lookup[item] = struct{}{}
+		})
+		if err != nil {
+			return false, err
+		}
+		s.lookup = lookup
+	}
+
+	for {
+		hasNext, err := s.first.Next()
+		if !hasNext || err != nil {
+			return hasNext, err
+		}
+
+		value, err := s.first.Value()
+		if err != nil {
+			return false, err
+		}
+
+		if _, ok := s.lookup[value]; ok != s.include {
+			continue
+		}
+
+		if _, ok := s.seen[value]; !ok {
+			s.seen[value] = struct{}{}
+			return true, nil
+		}
+	}
+}
+
+func (s *enumerableSetFilter[T]) Value() (T, error) {
+	return s.first.Value()
+}
+
+func (s *enumerableSetFilter[T]) Reset() {
+	s.lookup = nil
+	s.seen = map[T]struct{}{}
+	s.first.Reset()
+	s.second.Reset()
+}
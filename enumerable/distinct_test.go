@@ -0,0 +1,74 @@
+package enumerable
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDistinctYieldsFirstOccurrences(t *testing.T) {
+	distinct := Distinct(New([]int{3, 1, 3, 2, 1, 4}))
+
+	require.Equal(t, []int{3, 1, 2, 4}, collect(t, distinct))
+	// Re-enumeration should yield the same results
+	require.Equal(t, []int{3, 1, 2, 4}, collect(t, distinct))
+}
+
+func TestDistinctByYieldsFirstItemForEachKey(t *testing.T) {
+	distinct := DistinctBy(New([]int{1, 2, 3, 4, 5, 6}), mod3)
+
+	require.Equal(t, []int{1, 2, 3}, collect(t, distinct))
+}
+
+func TestDistinctByReturnsErrorGivenKeySelectorError(t *testing.T) {
+	expectedErr := errors.New("test error")
+	distinct := DistinctBy(New([]int{1, 2}), func(v int) (int, error) {
+		return 0, expectedErr
+	})
+
+	_, err := distinct.Next()
+	require.ErrorIs(t, err, expectedErr)
+}
+
+func TestUnionYieldsDistinctItemsFromBothSources(t *testing.T) {
+	union := Union(New([]int{1, 2, 2, 3}), New([]int{3, 4, 1, 5}))
+
+	require.Equal(t, []int{1, 2, 3, 4, 5}, collect(t, union))
+	// Re-enumeration should yield the same results
+	require.Equal(t, []int{1, 2, 3, 4, 5}, collect(t, union))
+}
+
+func TestIntersectYieldsDistinctItemsFoundInBothSources(t *testing.T) {
+	intersect := Intersect(New([]int{4, 1, 2, 4, 3}), New([]int{3, 4, 5}))
+
+	require.Equal(t, []int{4, 3}, collect(t, intersect))
+	// Re-enumeration should yield the same results
+	require.Equal(t, []int{4, 3}, collect(t, intersect))
+}
+
+func TestIntersectYieldsNothingGivenEmptySecond(t *testing.T) {
+	intersect := Intersect(New([]int{1, 2}), New([]int{}))
+
+	require.Empty(t, collect(t, intersect))
+}
+
+func TestExceptYieldsDistinctItemsNotFoundInSecond(t *testing.T) {
+	except := Except(New([]int{4, 1, 2, 1, 3}), New([]int{3, 4, 5}))
+
+	require.Equal(t, []int{1, 2}, collect(t, except))
+	// Re-enumeration should yield the same results
+	require.Equal(t, []int{1, 2}, collect(t, except))
+}
+
+func TestExceptReturnsErrorGivenSecondSourceError(t *testing.T) {
+	expectedErr := errors.New("test error")
+	second := Where(New([]int{1}), func(v int) (bool, error) {
+		return false, expectedErr
+	})
+
+	except := Except(New([]int{1, 2}), second)
+
+	_, err := except.Next()
+	require.ErrorIs(t, err, expectedErr)
+}
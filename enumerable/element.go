@@ -0,0 +1,169 @@
+package enumerable
+
+import (
+	"errors"
+
+	"github.com/sourcenetwork/immutable"
+)
+
+// ErrNoItems is returned by operations that require at least one item when the source yields
+// none.
+var ErrNoItems = errors.New("enumerable yielded no items")
+
+// ErrMultipleItems is returned by `Single` when the source yields more than one item.
+var ErrMultipleItems = errors.New("enumerable yielded more than one item")
+
+// Any returns true if any item yielded by the given source passes the given predicate.
+//
+// Enumeration stops at the first item that passes, leaving the source `Enumerable`
+// mid-iteration. The source is reset only if it is exhausted without error.
+func Any[T any](source Enumerable[T], predicate func(T) (bool, error)) (bool, error) {
+	found, err := find(source, predicate)
+	return found.HasValue(), err
+}
+
+// Every returns true if every item yielded by the given source passes the given predicate, or
+// if the source yields no items.
+//
+// Enumeration stops at the first item that does not pass, leaving the source `Enumerable`
+// mid-iteration. The source is reset only if it is exhausted without error.
+func Every[T any](source Enumerable[T], predicate func(T) (bool, error)) (bool, error) {
+	failed, err := find(source, func(item T) (bool, error) {
+		passes, err := predicate(item)
+		return !passes, err
+	})
+	return !failed.HasValue(), err
+}
+
+// Contains returns true if any item yielded by the given source is equal to the given value,
+// according to the given equality function.
+//
+// Enumeration stops at the first equal item, leaving the source `Enumerable` mid-iteration.
+// The source is reset only if it is exhausted without error.
+func Contains[T any](source Enumerable[T], value T, equal func(T, T) bool) (bool, error) {
+	return Any(source, func(item T) (bool, error) {
+		return equal(item, value), nil
+	})
+}
+
+// First returns the first item yielded by the given source, or `ErrNoItems` if it yields
+// none.
+//
+// Only the first item is read, leaving the source `Enumerable` mid-iteration, as
+// `TryGetFirst` does. The source is reset only if it yields no items.
+func First[T any](source Enumerable[T]) (T, error) {
+	first, err := FirstOrNone(source)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	if !first.HasValue() {
+		var zero T
+		return zero, ErrNoItems
+	}
+	return first.Value(), nil
+}
+
+// FirstOrNone returns the first item yielded by the given source, or no value if it yields
+// none.
+//
+// Only the first item is read, leaving the source `Enumerable` mid-iteration, as
+// `TryGetFirst` does. The source is reset only if it yields no items.
+func FirstOrNone[T any](source Enumerable[T]) (immutable.Option[T], error) {
+	return ElementAt(source, 0)
+}
+
+// Last returns the last item yielded by the given source, or `ErrNoItems` if it yields none.
+//
+// The whole source is read. The source `Enumerable` is reset on completion, but left
+// mid-iteration if an error is returned.
+func Last[T any](source Enumerable[T]) (T, error) {
+	last := immutable.None[T]()
+	err := ForEach(source, func(item T) {
+		last = immutable.Some(item)
+	})
+	if err == nil && !last.HasValue() {
+		err = ErrNoItems
+	}
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return last.Value(), nil
+}
+
+// Single returns the only item yielded by the given source. It returns `ErrNoItems` if the
+// source yields no items, and `ErrMultipleItems` if it yields more than one.
+//
+// Enumeration stops at the second item, leaving the source `Enumerable` mid-iteration if
+// there is one. The source is reset only if it is exhausted without error.
+func Single[T any](source Enumerable[T]) (T, error) {
+	var zero T
+	items := make([]T, 0, 2)
+	_, err := find(source, func(item T) (bool, error) {
+		items = append(items, item)
+		return len(items) > 1, nil
+	})
+	if err != nil {
+		return zero, err
+	}
+
+	switch len(items) {
+	case 0:
+		return zero, ErrNoItems
+	case 1:
+		return items[0], nil
+	default:
+		return zero, ErrMultipleItems
+	}
+}
+
+// ElementAt returns the item at the given zero-based index in the given source, or no value
+// if the source yields fewer items than that.
+//
+// Enumeration stops at the item at the given index, leaving the source `Enumerable`
+// mid-iteration. The source is reset only if it is exhausted without error.
+func ElementAt[T any](source Enumerable[T], index int) (immutable.Option[T], error) {
+	if index < 0 {
+		return immutable.None[T](), nil
+	}
+
+	i := 0
+	return find(source, func(item T) (bool, error) {
+		found := i == index
+		i++
+		return found, nil
+	})
+}
+
+// find returns the first item yielded by the given source that passes the given predicate,
+// or no value if no item passes.
+//
+// The source is left mid-iteration if an item passes or an error is returned, and is reset
+// if it is exhausted, as `ForEach` does.
+func find[T any](source Enumerable[T], predicate func(T) (bool, error)) (immutable.Option[T], error) {
+	for {
+		hasNext, err := source.Next()
+		if err != nil {
+			return immutable.None[T](), err
+		}
+		if !hasNext {
+			break
+		}
+
+		item, err := source.Value()
+		if err != nil {
+			return immutable.None[T](), err
+		}
+
+		passes, err := predicate(item)
+		if err != nil {
+			return immutable.None[T](), err
+		}
+		if passes {
+			return immutable.Some(item), nil
+		}
+	}
+	source.Reset()
+	return immutable.None[T](), nil
+}
@@ -0,0 +1,179 @@
+package enumerable
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+)
+
+func isEven(v int) (bool, error) {
+	return v%2 == 0, nil
+}
+
+func intEqual(a int, b int) bool {
+	return a == b
+}
+
+func TestAnyYieldsTrueGivenPassingItem(t *testing.T) {
+	source := New([]int{1, 2, 3})
+
+	result, err := Any(source, isEven)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	// The source should have been left after the passing item
+	require.Equal(t, []int{3}, collect(t, source))
+}
+
+func TestAnyYieldsFalseGivenNoPassingItems(t *testing.T) {
+	source := New([]int{1, 3})
+
+	result, err := Any(source, isEven)
+	require.NoError(t, err)
+	require.False(t, result)
+
+	// The exhausted source should have been reset
+	require.Equal(t, []int{1, 3}, collect(t, source))
+}
+
+func TestAnyLeavesRemainingQueueItems(t *testing.T) {
+	queue := NewQueue[int]()
+	require.NoError(t, queue.Put(1))
+	require.NoError(t, queue.Put(2))
+	require.NoError(t, queue.Put(3))
+
+	result, err := Any[int](queue, func(v int) (bool, error) {
+		return v == 1, nil
+	})
+	require.NoError(t, err)
+	require.True(t, result)
+
+	require.Equal(t, 2, queue.Len())
+	require.Equal(t, []int{2, 3}, drainQueue(t, queue))
+}
+
+func TestAnyStopsAtFirstPassingItem(t *testing.T) {
+	visited := []int{}
+	_, err := Any(New([]int{1, 2, 3, 4}), func(v int) (bool, error) {
+		visited = append(visited, v)
+		return isEven(v)
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2}, visited)
+}
+
+func TestAnyReturnsErrorGivenPredicateError(t *testing.T) {
+	expectedErr := errors.New("test error")
+
+	_, err := Any(New([]int{1}), func(v int) (bool, error) {
+		return false, expectedErr
+	})
+	require.ErrorIs(t, err, expectedErr)
+}
+
+func TestEveryYieldsTrueGivenAllPassingItems(t *testing.T) {
+	result, err := Every(New([]int{2, 4}), isEven)
+	require.NoError(t, err)
+	require.True(t, result)
+}
+
+func TestEveryYieldsTrueGivenEmptySource(t *testing.T) {
+	result, err := Every(New([]int{}), isEven)
+	require.NoError(t, err)
+	require.True(t, result)
+}
+
+func TestEveryYieldsFalseGivenFailingItem(t *testing.T) {
+	result, err := Every(New([]int{2, 3, 4}), isEven)
+	require.NoError(t, err)
+	require.False(t, result)
+}
+
+func TestContainsYieldsWhetherValueIsPresent(t *testing.T) {
+	result, err := Contains(New([]int{1, 2, 3}), 2, intEqual)
+	require.NoError(t, err)
+	require.True(t, result)
+
+	result, err = Contains(New([]int{1, 2, 3}), 5, intEqual)
+	require.NoError(t, err)
+	require.False(t, result)
+}
+
+func TestFirstYieldsFirstItem(t *testing.T) {
+	source := New([]int{7, 8})
+
+	result, err := First(source)
+	require.NoError(t, err)
+	require.Equal(t, 7, result)
+
+	// The source should have been left after the first item
+	require.Equal(t, []int{8}, collect(t, source))
+}
+
+func TestFirstLeavesRemainingQueueItems(t *testing.T) {
+	queue := NewQueue[int]()
+	require.NoError(t, queue.Put(1))
+	require.NoError(t, queue.Put(2))
+	require.NoError(t, queue.Put(3))
+
+	result, err := First[int](queue)
+	require.NoError(t, err)
+	require.Equal(t, 1, result)
+
+	require.Equal(t, 2, queue.Len())
+	require.Equal(t, []int{2, 3}, drainQueue(t, queue))
+}
+
+func TestFirstReturnsErrorGivenEmptySource(t *testing.T) {
+	_, err := First(New([]int{}))
+	require.ErrorIs(t, err, ErrNoItems)
+}
+
+func TestFirstOrNoneYieldsNoneGivenEmptySource(t *testing.T) {
+	result, err := FirstOrNone(New([]int{}))
+	require.NoError(t, err)
+	require.False(t, result.HasValue())
+}
+
+func TestLastYieldsLastItem(t *testing.T) {
+	result, err := Last(New([]int{7, 8, 9}))
+	require.NoError(t, err)
+	require.Equal(t, 9, result)
+}
+
+func TestLastReturnsErrorGivenEmptySource(t *testing.T) {
+	_, err := Last(New([]int{}))
+	require.ErrorIs(t, err, ErrNoItems)
+}
+
+func TestSingleYieldsOnlyItem(t *testing.T) {
+	result, err := Single(New([]int{7}))
+	require.NoError(t, err)
+	require.Equal(t, 7, result)
+}
+
+func TestSingleReturnsErrorGivenEmptySource(t *testing.T) {
+	_, err := Single(New([]int{}))
+	require.ErrorIs(t, err, ErrNoItems)
+}
+
+func TestSingleReturnsErrorGivenMultipleItems(t *testing.T) {
+	_, err := Single(New([]int{7, 8, 9}))
+	require.ErrorIs(t, err, ErrMultipleItems)
+}
+
+func TestElementAtYieldsItemAtIndex(t *testing.T) {
+	result, err := ElementAt(New([]int{7, 8, 9}), 1)
+	require.NoError(t, err)
+	require.Equal(t, immutable.Some(8), result)
+
+	result, err = ElementAt(New([]int{7, 8, 9}), 3)
+	require.NoError(t, err)
+	require.False(t, result.HasValue())
+
+	result, err = ElementAt(New([]int{7, 8, 9}), -1)
+	require.NoError(t, err)
+	require.False(t, result.HasValue())
+}
@@ -0,0 +1,191 @@
+package enumerable
+
+// Grouping is an `Enumerable` of items that share a common key.
+type Grouping[K any, T any] interface {
+	Enumerable[T]
+	// Key returns the key shared by the items in this grouping.
+	Key() K
+}
+
+type grouping[K any, T any] struct {
+	Enumerable[T]
+	key K
+}
+
+var _ Grouping[any, any] = (*grouping[any, any])(nil)
+
+func newGrouping[K any, T any](key K, items []T) *grouping[K, T] {
+	return &grouping[K, T]{
+		Enumerable: New(items),
+		key:        key,
+	}
+}
+
+func (g *grouping[K, T]) Key() K {
+	return g.key
+}
+
+type enumerableGroupBy[T any, K comparable] struct {
+	source      Enumerable[T]
+	keySelector func(T) (K, error)
+	result      Enumerable[Grouping[K, T]]
+}
+
+// GroupBy creates an `Enumerable` that yields a `Grouping` for each distinct key returned
+// by the given key selector, containing the items from the source that have that key.
+//
+// Groupings are yielded in the order in which their keys were first seen, and the items
+// within them retain their order from the source.
+//
+// The returned `Enumerable` will enumerate the entire source enumerable on the first `Next`
+// call, but will not enumerate it again unless reset. If the source is already ordered by
+// key, `GroupAdjacent` may be used to avoid this.
+func GroupBy[T any, K comparable](source Enumerable[T], keySelector func(T) (K, error)) Enumerable[Grouping[K, T]] {
+	return &enumerableGroupBy[T, K]{
+		source:      source,
+		keySelector: keySelector,
+	}
+}
+
+func (s *enumerableGroupBy[T, K]) Next() (bool, error) {
+	if s.result == nil {
+		keys := []K{}
+		itemsByKey := map[K][]T{}
+
+		for {
+			hasNext, err := s.source.Next()
+			if err != nil {
+				return false, err
+			}
+			if !hasNext {
+				break
+			}
+
+			value, err := s.source.Value()
+			if err != nil {
+				return false, err
+			}
+
+			key, err := s.keySelector(value)
+			if err != nil {
+				return false, err
+			}
+
+			items, ok := itemsByKey[key]
+			if !ok {
+				keys = append(keys, key)
+			}
+			itemsByKey[key] = append(items, value)
+		}
+
+		groupings := make([]Grouping[K, T], len(keys))
+		for i, key := range keys {
+			groupings[i] = newGrouping(key, itemsByKey[key])
+		}
+		s.result = New(groupings)
+	}
+
+	return s.result.Next()
+}
+
+func (s *enumerableGroupBy[T, K]) Value() (Grouping[K, T], error) {
+	return s.result.Value()
+}
+
+func (s *enumerableGroupBy[T, K]) Reset() {
+	// s.result should be cleared, not reset, as Reset should
+	// enable the re-enumeration of the entire enumeration chain,
+	// not just the last step.
+	s.result = nil
+	s.source.Reset()
+}
+
+type enumerableGroupAdjacent[T any, K comparable] struct {
+	source      Enumerable[T]
+	keySelector func(T) (K, error)
+
+	// The first item of the next grouping, read from the source whilst
+	// searching for the end of the current grouping.
+	pendingValue T
+	pendingKey   K
+	hasPending   bool
+
+	currentValue Grouping[K, T]
+}
+
+// GroupAdjacent creates an `Enumerable` that yields a `Grouping` for each run of consecutive
+// items in the source that share the same key, as returned by the given key selector.
+//
+// Unlike `GroupBy`, only the items of the current grouping are held in memory, but items
+// with the same key will be yielded in separate groupings unless the source is ordered by key.
+func GroupAdjacent[T any, K comparable](
+	source Enumerable[T],
+	keySelector func(T) (K, error),
+) Enumerable[Grouping[K, T]] {
+	return &enumerableGroupAdjacent[T, K]{
+		source:      source,
+		keySelector: keySelector,
+	}
+}
+
+func (s *enumerableGroupAdjacent[T, K]) Next() (bool, error) {
+	if !s.hasPending {
+		hasNext, err := s.readPending()
+		if !hasNext || err != nil {
+			return false, err
+		}
+	}
+
+	key := s.pendingKey
+	items := []T{s.pendingValue}
+	s.hasPending = false
+
+	for {
+		hasNext, err := s.readPending()
+		if err != nil {
+			return false, err
+		}
+		if !hasNext || s.pendingKey != key {
+			break
+		}
+		items = append(items, s.pendingValue)
+		s.hasPending = false
+	}
+
+	s.currentValue = newGrouping(key, items)
+	return true, nil
+}
+
+// readPending reads the next item from the source into the pending fields.
+func (s *enumerableGroupAdjacent[T, K]) readPending() (bool, error) {
+	hasNext, err := s.source.Next()
+	if !hasNext || err != nil {
+		return false, err
+	}
+
+	value, err := s.source.Value()
+	if err != nil {
+		return false, err
+	}
+
+	key, err := s.keySelector(value)
+	if err != nil {
+		return false, err
+	}
+
+	s.pendingValue = value
+	s.pendingKey = key
+	s.hasPending = true
+	return true, nil
+}
+
+func (s *enumerableGroupAdjacent[T, K]) Value() (Grouping[K, T], error) {
+	return s.currentValue, nil
+}
+
+func (s *enumerableGroupAdjacent[T, K]) Reset() {
+	var zero T
+	s.pendingValue = zero
+	s.hasPending = false
+	s.source.Reset()
+}
@@ -0,0 +1,100 @@
+package enumerable
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type groupResult struct {
+	key   int
+	items []int
+}
+
+func collectGroups(t *testing.T, source Enumerable[Grouping[int, int]]) []groupResult {
+	results := []groupResult{}
+	err := ForEach(source, func(g Grouping[int, int]) {
+		results = append(results, groupResult{key: g.Key(), items: collect[int](t, g)})
+	})
+	require.NoError(t, err)
+	return results
+}
+
+func mod3(v int) (int, error) {
+	return v % 3, nil
+}
+
+func TestGroupByYieldsGroupsInFirstSeenOrder(t *testing.T) {
+	source := New([]int{4, 3, 1, 6, 7, 2})
+
+	require.Equal(
+		t,
+		[]groupResult{
+			{key: 1, items: []int{4, 1, 7}},
+			{key: 0, items: []int{3, 6}},
+			{key: 2, items: []int{2}},
+		},
+		collectGroups(t, GroupBy(source, mod3)),
+	)
+}
+
+func TestGroupByYieldsNothingGivenEmpty(t *testing.T) {
+	require.Equal(t, []groupResult{}, collectGroups(t, GroupBy(New([]int{}), mod3)))
+}
+
+func TestGroupByYieldsSameGroupsGivenReset(t *testing.T) {
+	groups := GroupBy(New([]int{1, 2, 4}), mod3)
+
+	first := collectGroups(t, groups)
+	second := collectGroups(t, groups)
+	require.Equal(t, first, second)
+}
+
+func TestGroupByReturnsKeySelectorError(t *testing.T) {
+	expectedErr := errors.New("test error")
+	groups := GroupBy(New([]int{1}), func(v int) (int, error) {
+		return 0, expectedErr
+	})
+
+	hasNext, err := groups.Next()
+	require.ErrorIs(t, err, expectedErr)
+	require.False(t, hasNext)
+}
+
+func TestGroupAdjacentYieldsRunsOfKeys(t *testing.T) {
+	source := New([]int{1, 4, 3, 6, 7, 2})
+
+	require.Equal(
+		t,
+		[]groupResult{
+			{key: 1, items: []int{1, 4}},
+			{key: 0, items: []int{3, 6}},
+			{key: 1, items: []int{7}},
+			{key: 2, items: []int{2}},
+		},
+		collectGroups(t, GroupAdjacent(source, mod3)),
+	)
+}
+
+func TestGroupAdjacentYieldsNothingGivenEmpty(t *testing.T) {
+	require.Equal(t, []groupResult{}, collectGroups(t, GroupAdjacent(New([]int{}), mod3)))
+}
+
+func TestGroupAdjacentYieldsSameGroupsGivenReset(t *testing.T) {
+	groups := GroupAdjacent(New([]int{1, 4, 2}), mod3)
+
+	hasNext, err := groups.Next()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+	groups.Reset()
+
+	require.Equal(
+		t,
+		[]groupResult{
+			{key: 1, items: []int{1, 4}},
+			{key: 2, items: []int{2}},
+		},
+		collectGroups(t, groups),
+	)
+}
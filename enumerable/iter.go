@@ -0,0 +1,117 @@
+//go:build go1.23
+
+package enumerable
+
+import "iter"
+
+// All returns an `iter.Seq` that yields the items of the given source, allowing it to
+// be used in a range-over-func loop.
+//
+// Iteration will stop at the first error, use `AllWithErrors` if errors need to be
+// handled. The source is reset when iteration ends, unless it ends due to an error.
+func All[T any](source Enumerable[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item, err := range AllWithErrors(source) {
+			if err != nil || !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// AllWithErrors returns an `iter.Seq2` that yields the items of the given source
+// alongside a nil error, allowing it to be used in a range-over-func loop.
+//
+// If an error is generated during enumeration, it will be yielded alongside the default
+// value of `T` and iteration will stop. The source is reset when iteration ends, unless
+// it ends due to an error.
+func AllWithErrors[T any](source Enumerable[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			hasNext, err := source.Next()
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			if !hasNext {
+				break
+			}
+			item, err := source.Value()
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+			if !yield(item, nil) {
+				break
+			}
+		}
+		source.Reset()
+	}
+}
+
+type enumerableSeq[T any] struct {
+	seq          iter.Seq2[T, error]
+	next         func() (T, error, bool)
+	stop         func()
+	currentValue T
+}
+
+// FromSeq creates an `Enumerable` from the given `iter.Seq`.
+//
+// The sequence is invoked on the first `Next` call, and will be invoked again after
+// `Reset`. If enumeration is abandoned before the sequence has been exhausted, the
+// returned `Enumerable` must be reset in order to release the resources held by the
+// sequence.
+func FromSeq[T any](seq iter.Seq[T]) Enumerable[T] {
+	return FromSeq2(func(yield func(T, error) bool) {
+		for item := range seq {
+			if !yield(item, nil) {
+				return
+			}
+		}
+	})
+}
+
+// FromSeq2 creates an `Enumerable` from the given `iter.Seq2`. Any non-nil errors
+// yielded by the sequence will be returned from `Next`.
+//
+// The sequence is invoked on the first `Next` call, and will be invoked again after
+// `Reset`. If enumeration is abandoned before the sequence has been exhausted, the
+// returned `Enumerable` must be reset in order to release the resources held by the
+// sequence.
+func FromSeq2[T any](seq iter.Seq2[T, error]) Enumerable[T] {
+	return &enumerableSeq[T]{
+		seq: seq,
+	}
+}
+
+func (s *enumerableSeq[T]) Next() (bool, error) {
+	if s.next == nil {
+		s.next, s.stop = iter.Pull2(s.seq)
+	}
+
+	value, err, ok := s.next()
+	if !ok {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	s.currentValue = value
+	return true, nil
+}
+
+func (s *enumerableSeq[T]) Value() (T, error) {
+	return s.currentValue, nil
+}
+
+func (s *enumerableSeq[T]) Reset() {
+	if s.stop != nil {
+		s.stop()
+	}
+	s.next = nil
+	s.stop = nil
+}
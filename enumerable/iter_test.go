@@ -0,0 +1,122 @@
+//go:build go1.23
+
+package enumerable
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAllYieldsItems(t *testing.T) {
+	source := New([]int{1, 2, 3})
+
+	results := []int{}
+	for v := range All(source) {
+		results = append(results, v)
+	}
+	require.Equal(t, []int{1, 2, 3}, results)
+
+	// The source should have been reset, allowing re-iteration
+	results = slices.Collect(All(source))
+	require.Equal(t, []int{1, 2, 3}, results)
+}
+
+func TestAllResetsSourceGivenBreak(t *testing.T) {
+	source := New([]int{1, 2, 3})
+
+	for v := range All(source) {
+		if v == 2 {
+			break
+		}
+	}
+
+	hasNext, err := source.Next()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+
+	v, err := source.Value()
+	require.NoError(t, err)
+	require.Equal(t, 1, v)
+}
+
+func TestAllWithErrorsYieldsError(t *testing.T) {
+	expectedErr := errors.New("test error")
+	source := Where(New([]int{1, 2, 3}), func(v int) (bool, error) {
+		if v == 2 {
+			return false, expectedErr
+		}
+		return true, nil
+	})
+
+	results := []int{}
+	var resultErr error
+	for v, err := range AllWithErrors(source) {
+		if err != nil {
+			resultErr = err
+			break
+		}
+		results = append(results, v)
+	}
+	require.ErrorIs(t, resultErr, expectedErr)
+	require.Equal(t, []int{1}, results)
+}
+
+func TestFromSeqYieldsItems(t *testing.T) {
+	source := FromSeq(slices.Values([]int{1, 2, 3}))
+
+	results := []int{}
+	err := ForEach(source, func(v int) {
+		results = append(results, v)
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, results)
+}
+
+func TestFromSeqReinvokesSequenceGivenReset(t *testing.T) {
+	invocations := 0
+	source := FromSeq(func(yield func(int) bool) {
+		invocations++
+		for i := 1; i <= 3; i++ {
+			if !yield(i) {
+				return
+			}
+		}
+	})
+
+	hasNext, err := source.Next()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+
+	source.Reset()
+
+	results := []int{}
+	err = ForEach(source, func(v int) {
+		results = append(results, v)
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, results)
+	require.Equal(t, 2, invocations)
+}
+
+func TestFromSeq2ReturnsError(t *testing.T) {
+	expectedErr := errors.New("test error")
+	source := FromSeq2(func(yield func(int, error) bool) {
+		if !yield(1, nil) {
+			return
+		}
+		yield(0, expectedErr)
+	})
+
+	hasNext, err := source.Next()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+
+	hasNext, err = source.Next()
+	require.ErrorIs(t, err, expectedErr)
+	require.False(t, hasNext)
+
+	source.Reset()
+}
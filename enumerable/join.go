@@ -0,0 +1,236 @@
+package enumerable
+
+import "github.com/sourcenetwork/immutable"
+
+// buildLookup enumerates the given source, returning its items grouped by the key returned
+// by the given key selector.
+func buildLookup[T any, K comparable](source Enumerable[T], keySelector func(T) (K, error)) (map[K][]T, error) {
+	lookup := map[K][]T{}
+	for {
+		hasNext, err := source.Next()
+		if err != nil {
+			return nil, err
+		}
+		if !hasNext {
+			return lookup, nil
+		}
+
+		value, err := source.Value()
+		if err != nil {
+			return nil, err
+		}
+
+		key, err := keySelector(value)
+		if err != nil {
+			return nil, err
+		}
+
+		lookup[key] = append(lookup[key], value)
+	}
+}
+
+type enumerableJoin[TOuter any, TInner any, K comparable, TResult any] struct {
+	outer            Enumerable[TOuter]
+	inner            Enumerable[TInner]
+	outerKeySelector func(TOuter) (K, error)
+	innerKeySelector func(TInner) (K, error)
+	resultSelector   func(TOuter, immutable.Option[TInner]) (TResult, error)
+
+	// If true, outer items with no matching inner items will be yielded alongside
+	// `None`.
+	includeUnmatched bool
+
+	// The inner items, grouped by key.
+	lookup map[K][]TInner
+
+	currentOuter TOuter
+	matches      []TInner
+	matchIndex   int
+	currentValue TResult
+}
+
+// Join creates an `Enumerable` that yields the result of the given result selector for each
+// pair of items from the outer and inner sources with equal keys.
+//
+// Results are yielded in the order of the outer source, and then the inner source.
+//
+// The inner source is enumerated in its entirety on the first `Next` call and held in memory,
+// the outer source is enumerated lazily. Both sources are reset on `Reset`.
+func Join[TOuter any, TInner any, K comparable, TResult any](
+	outer Enumerable[TOuter],
+	inner Enumerable[TInner],
+	outerKeySelector func(TOuter) (K, error),
+	innerKeySelector func(TInner) (K, error),
+	resultSelector func(TOuter, TInner) (TResult, error),
+) Enumerable[TResult] {
+	return &enumerableJoin[TOuter, TInner, K, TResult]{
+		outer:            outer,
+		inner:            inner,
+		outerKeySelector: outerKeySelector,
+		innerKeySelector: innerKeySelector,
+		resultSelector: func(o TOuter, i immutable.Option[TInner]) (TResult, error) {
+			return resultSelector(o, i.Value())
+		},
+	}
+}
+
+// LeftJoin behaves like `Join`, except that items from the outer source with no matching
+// items in the inner source are yielded alongside `None`.
+func LeftJoin[TOuter any, TInner any, K comparable, TResult any](
+	outer Enumerable[TOuter],
+	inner Enumerable[TInner],
+	outerKeySelector func(TOuter) (K, error),
+	innerKeySelector func(TInner) (K, error),
+	resultSelector func(TOuter, immutable.Option[TInner]) (TResult, error),
+) Enumerable[TResult] {
+	return &enumerableJoin[TOuter, TInner, K, TResult]{
+		outer:            outer,
+		inner:            inner,
+		outerKeySelector: outerKeySelector,
+		innerKeySelector: innerKeySelector,
+		resultSelector:   resultSelector,
+		includeUnmatched: true,
+	}
+}
+
+func (s *enumerableJoin[TOuter, TInner, K, TResult]) Next() (bool, error) {
+	if s.lookup == nil {
+		lookup, err := buildLookup(s.inner, s.innerKeySelector)
+		if err != nil {
+			return false, err
+		}
+		s.lookup = lookup
+	}
+
+	for s.matchIndex >= len(s.matches) {
+		hasNext, err := s.outer.Next()
+		if !hasNext || err != nil {
+			return hasNext, err
+		}
+
+		outerValue, err := s.outer.Value()
+		if err != nil {
+			return false, err
+		}
+
+		key, err := s.outerKeySelector(outerValue)
+		if err != nil {
+			return false, err
+		}
+
+		s.currentOuter = outerValue
+		s.matches = s.lookup[key]
+		s.matchIndex = 0
+
+		if len(s.matches) == 0 && s.includeUnmatched {
+			result, err := s.resultSelector(outerValue, immutable.None[TInner]())
+			if err != nil {
+				return false, err
+			}
+			s.currentValue = result
+			return true, nil
+		}
+	}
+
+	result, err := s.resultSelector(s.currentOuter, immutable.Some(s.matches[s.matchIndex]))
+	if err != nil {
+		return false, err
+	}
+	s.matchIndex += 1
+	s.currentValue = result
+	return true, nil
+}
+
+func (s *enumerableJoin[TOuter, TInner, K, TResult]) Value() (TResult, error) {
+	return s.currentValue, nil
+}
+
+func (s *enumerableJoin[TOuter, TInner, K, TResult]) Reset() {
+	s.lookup = nil
+	s.matches = nil
+	s.matchIndex = 0
+	s.outer.Reset()
+	s.inner.Reset()
+}
+
+type enumerableGroupJoin[TOuter any, TInner any, K comparable, TResult any] struct {
+	outer            Enumerable[TOuter]
+	inner            Enumerable[TInner]
+	outerKeySelector func(TOuter) (K, error)
+	innerKeySelector func(TInner) (K, error)
+	resultSelector   func(TOuter, Enumerable[TInner]) (TResult, error)
+
+	// The inner items, grouped by key.
+	lookup map[K][]TInner
+
+	currentValue TResult
+}
+
+// GroupJoin creates an `Enumerable` that yields the result of the given result selector for
+// each item in the outer source, alongside an `Enumerable` of the items in the inner source
+// with an equal key.
+//
+// The inner source is enumerated in its entirety on the first `Next` call and held in memory,
+// the outer source is enumerated lazily. Both sources are reset on `Reset`.
+func GroupJoin[TOuter any, TInner any, K comparable, TResult any](
+	outer Enumerable[TOuter],
+	inner Enumerable[TInner],
+	outerKeySelector func(TOuter) (K, error),
+	innerKeySelector func(TInner) (K, error),
+	resultSelector func(TOuter, Enumerable[TInner]) (TResult, error),
+) Enumerable[TResult] {
+	return &enumerableGroupJoin[TOuter, TInner, K, TResult]{
+		outer:            outer,
+		inner:            inner,
+		outerKeySelector: outerKeySelector,
+		innerKeySelector: innerKeySelector,
+		resultSelector:   resultSelector,
+	}
+}
+
+func (s *enumerableGroupJoin[TOuter, TInner, K, TResult]) Next() (bool, error) {
+	if s.lookup == nil {
+		lookup, err := buildLookup(s.inner, s.innerKeySelector)
+		if err != nil {
+			return false, err
+		}
+		s.lookup = lookup
+	}
+
+	hasNext, err := s.outer.Next()
+	if !hasNext || err != nil {
+		return hasNext, err
+	}
+
+	outerValue, err := s.outer.Value()
+	if err != nil {
+		return false, err
+	}
+
+	key, err := s.outerKeySelector(outerValue)
+	if err != nil {
+		return false, err
+	}
+
+	matches := s.lookup[key]
+	if matches == nil {
+		matches = []TInner{}
+	}
+
+	result, err := s.resultSelector(outerValue, New(matches))
+	if err != nil {
+		return false, err
+	}
+	s.currentValue = result
+	return true, nil
+}
+
+func (s *enumerableGroupJoin[TOuter, TInner, K, TResult]) Value() (TResult, error) {
+	return s.currentValue, nil
+}
+
+func (s *enumerableGroupJoin[TOuter, TInner, K, TResult]) Reset() {
+	s.lookup = nil
+	s.outer.Reset()
+	s.inner.Reset()
+}
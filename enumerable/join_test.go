@@ -0,0 +1,114 @@
+package enumerable
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+)
+
+type joinTestUser struct {
+	id   int
+	name string
+}
+
+type joinTestDoc struct {
+	ownerID int
+	title   string
+}
+
+var joinTestUsers = []joinTestUser{{1, "alice"}, {2, "bob"}, {3, "carol"}}
+
+var joinTestDocs = []joinTestDoc{{1, "a"}, {3, "b"}, {1, "c"}, {4, "d"}}
+
+func userID(u joinTestUser) (int, error) {
+	return u.id, nil
+}
+
+func docOwnerID(d joinTestDoc) (int, error) {
+	return d.ownerID, nil
+}
+
+func TestJoinYieldsMatchingPairs(t *testing.T) {
+	joined := Join(
+		New(joinTestUsers),
+		New(joinTestDocs),
+		userID,
+		docOwnerID,
+		func(u joinTestUser, d joinTestDoc) (string, error) {
+			return u.name + ":" + d.title, nil
+		},
+	)
+
+	require.Equal(t, []string{"alice:a", "alice:c", "carol:b"}, collect(t, joined))
+	// Re-enumeration should yield the same results
+	require.Equal(t, []string{"alice:a", "alice:c", "carol:b"}, collect(t, joined))
+}
+
+func TestJoinYieldsNothingGivenEmptyInner(t *testing.T) {
+	joined := Join(
+		New(joinTestUsers),
+		New([]joinTestDoc{}),
+		userID,
+		docOwnerID,
+		func(u joinTestUser, d joinTestDoc) (string, error) {
+			return u.name, nil
+		},
+	)
+
+	require.Equal(t, []string{}, collect(t, joined))
+}
+
+func TestJoinReturnsKeySelectorError(t *testing.T) {
+	expectedErr := errors.New("test error")
+	joined := Join(
+		New(joinTestUsers),
+		New(joinTestDocs),
+		userID,
+		func(d joinTestDoc) (int, error) {
+			return 0, expectedErr
+		},
+		func(u joinTestUser, d joinTestDoc) (string, error) {
+			return u.name, nil
+		},
+	)
+
+	hasNext, err := joined.Next()
+	require.ErrorIs(t, err, expectedErr)
+	require.False(t, hasNext)
+}
+
+func TestLeftJoinYieldsNoneGivenNoMatch(t *testing.T) {
+	joined := LeftJoin(
+		New(joinTestUsers),
+		New(joinTestDocs),
+		userID,
+		docOwnerID,
+		func(u joinTestUser, d immutable.Option[joinTestDoc]) (string, error) {
+			if !d.HasValue() {
+				return u.name + ":-", nil
+			}
+			return u.name + ":" + d.Value().title, nil
+		},
+	)
+
+	require.Equal(t, []string{"alice:a", "alice:c", "bob:-", "carol:b"}, collect(t, joined))
+}
+
+func TestGroupJoinYieldsEachOuterItem(t *testing.T) {
+	joined := GroupJoin(
+		New(joinTestUsers),
+		New(joinTestDocs),
+		userID,
+		docOwnerID,
+		func(u joinTestUser, docs Enumerable[joinTestDoc]) (string, error) {
+			count := 0
+			err := OnEach(docs, func() { count++ })
+			return fmt.Sprintf("%s:%d", u.name, count), err
+		},
+	)
+
+	require.Equal(t, []string{"alice:2", "bob:0", "carol:1"}, collect(t, joined))
+}
@@ -0,0 +1,114 @@
+package enumerable
+
+import "fmt"
+
+// DuplicateKeyPolicy determines how `ToMap` behaves when more than one item maps to the
+// same key.
+type DuplicateKeyPolicy int
+
+const (
+	// DuplicateError causes `ToMap` to return a `DuplicateKeyError`.
+	DuplicateError DuplicateKeyPolicy = iota
+	// DuplicateFirstWins causes the value of the first item with the key to be kept.
+	DuplicateFirstWins
+	// DuplicateLastWins causes the value of the last item with the key to be kept.
+	DuplicateLastWins
+)
+
+// DuplicateKeyError is returned by `ToMap` when more than one item maps to the same key with
+// the `DuplicateError` policy.
+type DuplicateKeyError struct {
+	// The duplicated key.
+	Key any
+}
+
+func (e *DuplicateKeyError) Error() string {
+	return fmt.Sprintf("duplicate key: %v", e.Key)
+}
+
+// ToSlice returns the items yielded by the given source as a slice.
+//
+// An optional capacity hint may be given, which is used as the initial capacity of the
+// returned slice. It resets the source `Enumerable` on completion.
+func ToSlice[T any](source Enumerable[T], capacity ...int) ([]T, error) {
+	initialCapacity := 0
+	if len(capacity) > 0 {
+		initialCapacity = capacity[0]
+	}
+
+	result := make([]T, 0, initialCapacity)
+	err := ForEach(source, func(item T) {
+		result = append(result, item)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ToMap returns a map from the key to the value returned by the given selectors for each item
+// yielded by the given source, using the given policy when more than one item maps to the
+// same key.
+//
+// It resets the source `Enumerable` on completion.
+func ToMap[T any, K comparable, V any](
+	source Enumerable[T],
+	keySelector func(T) (K, error),
+	valueSelector func(T) (V, error),
+	policy DuplicateKeyPolicy,
+) (map[K]V, error) {
+	result, err := Aggregate(source, map[K]V{}, func(result map[K]V, item T) (map[K]V, error) {
+		key, err := keySelector(item)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := result[key]; ok {
+			switch policy {
+			case DuplicateError:
+				return nil, &DuplicateKeyError{Key: key}
+			case DuplicateFirstWins:
+				return result, nil
+			}
+		}
+
+		value, err := valueSelector(item)
+		if err != nil {
+			return nil, err
+		}
+		result[key] = value
+		return result, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ToLookup returns the items yielded by the given source grouped by the key returned by the
+// given key selector. Items within each group are held in the order they were yielded.
+//
+// It resets the source `Enumerable` on completion.
+func ToLookup[T any, K comparable](source Enumerable[T], keySelector func(T) (K, error)) (map[K][]T, error) {
+	lookup, err := buildLookup(source, keySelector)
+	if err != nil {
+		return nil, err
+	}
+	source.Reset()
+	return lookup, nil
+}
+
+// ToQueue returns a new `Queue` holding the items yielded by the given source, in the order
+// they were yielded.
+//
+// It resets the source `Enumerable` on completion.
+func ToQueue[T any](source Enumerable[T]) (Queue[T], error) {
+	queue := NewQueue[T]()
+	_, err := Aggregate(source, queue, func(queue Queue[T], item T) (Queue[T], error) {
+		return queue, queue.Put(item)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return queue, nil
+}
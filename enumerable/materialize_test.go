@@ -0,0 +1,94 @@
+package enumerable
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestToSliceYieldsItems(t *testing.T) {
+	source := New([]int{1, 2, 3})
+
+	result, err := ToSlice(source)
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2, 3}, result)
+
+	// The source should have been reset
+	require.Equal(t, []int{1, 2, 3}, collect(t, source))
+}
+
+func TestToSliceUsesCapacityHint(t *testing.T) {
+	result, err := ToSlice(New([]int{1}), 10)
+	require.NoError(t, err)
+	require.Equal(t, []int{1}, result)
+	require.Equal(t, 10, cap(result))
+}
+
+func TestToSliceYieldsEmptySliceGivenEmptySource(t *testing.T) {
+	result, err := ToSlice(New([]int{}))
+	require.NoError(t, err)
+	require.NotNil(t, result)
+	require.Empty(t, result)
+}
+
+func TestToMapYieldsMap(t *testing.T) {
+	result, err := ToMap(New(joinTestUsers), userID, userName, DuplicateError)
+	require.NoError(t, err)
+	require.Equal(t, map[int]string{1: "alice", 2: "bob", 3: "carol"}, result)
+}
+
+func TestToMapReturnsErrorGivenDuplicateKeyWithErrorPolicy(t *testing.T) {
+	_, err := ToMap(New(joinTestDocs), docOwnerID, docTitle, DuplicateError)
+
+	var duplicateErr *DuplicateKeyError
+	require.ErrorAs(t, err, &duplicateErr)
+	require.Equal(t, 1, duplicateErr.Key)
+}
+
+func TestToMapKeepsFirstValueGivenFirstWinsPolicy(t *testing.T) {
+	result, err := ToMap(New(joinTestDocs), docOwnerID, docTitle, DuplicateFirstWins)
+	require.NoError(t, err)
+	require.Equal(t, map[int]string{1: "a", 3: "b", 4: "d"}, result)
+}
+
+func TestToMapKeepsLastValueGivenLastWinsPolicy(t *testing.T) {
+	result, err := ToMap(New(joinTestDocs), docOwnerID, docTitle, DuplicateLastWins)
+	require.NoError(t, err)
+	require.Equal(t, map[int]string{1: "c", 3: "b", 4: "d"}, result)
+}
+
+func TestToMapReturnsErrorGivenValueSelectorError(t *testing.T) {
+	expectedErr := errors.New("test error")
+
+	_, err := ToMap(New(joinTestUsers), userID, func(u joinTestUser) (string, error) {
+		return "", expectedErr
+	}, DuplicateLastWins)
+	require.ErrorIs(t, err, expectedErr)
+}
+
+func TestToLookupYieldsGroupedItems(t *testing.T) {
+	source := New([]int{1, 2, 3, 4, 5})
+
+	result, err := ToLookup(source, mod3)
+	require.NoError(t, err)
+	require.Equal(t, map[int][]int{0: {3}, 1: {1, 4}, 2: {2, 5}}, result)
+
+	// The source should have been reset
+	require.Equal(t, []int{1, 2, 3, 4, 5}, collect(t, source))
+}
+
+func TestToQueueYieldsQueueOfItems(t *testing.T) {
+	queue, err := ToQueue(New([]int{1, 2, 3}))
+	require.NoError(t, err)
+	require.Equal(t, 3, queue.Len())
+	require.Equal(t, []int{1, 2, 3}, drainQueue(t, queue))
+}
+
+func userName(u joinTestUser) (string, error) {
+	return u.name, nil
+}
+
+func docTitle(d joinTestDoc) (string, error) {
+	return d.title, nil
+}
@@ -0,0 +1,339 @@
+package enumerable
+
+import "github.com/sourcenetwork/immutable"
+
+type enumerableMergeSorted[T any] struct {
+	sources []Enumerable[T]
+	less    func(T, T) bool
+
+	// The indexes of the sources that have a current item, arranged as a binary min-heap
+	// ordered by their current items.
+	heap []int
+
+	// The current item of each source.
+	heads []T
+
+	started bool
+
+	// The index of the source whose item was last yielded, and must be advanced before
+	// the next item can be determined. -1 if there is no such source.
+	lastSourceIndex int
+
+	currentValue T
+}
+
+// MergeSorted creates an `Enumerable` that merges the given sources, each of which must
+// already be ordered by the given less function, into a single ordered enumerable.
+//
+// Only the current item of each source is held in memory. Items that are equal are yielded
+// in the order of the sources they came from.
+func MergeSorted[T any](less func(T, T) bool, sources ...Enumerable[T]) Enumerable[T] {
+	return &enumerableMergeSorted[T]{
+		sources:         sources,
+		less:            less,
+		heads:           make([]T, len(sources)),
+		lastSourceIndex: -1,
+	}
+}
+
+func (s *enumerableMergeSorted[T]) Next() (bool, error) {
+	if !s.started {
+		s.started = true
+		for i := range s.sources {
+			err := s.advance(i)
+			if err != nil {
+				return false, err
+			}
+		}
+	} else if s.lastSourceIndex >= 0 {
+		// The last yielded source is at the root of the heap, so it is removed and
+		// then re-added if it has another item.
+		s.pop()
+		err := s.advance(s.lastSourceIndex)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	if len(s.heap) == 0 {
+		s.lastSourceIndex = -1
+		return false, nil
+	}
+
+	s.lastSourceIndex = s.heap[0]
+	s.currentValue = s.heads[s.lastSourceIndex]
+	return true, nil
+}
+
+// advance reads the next item from the source at the given index, adding it to the heap if
+// it has one.
+func (s *enumerableMergeSorted[T]) advance(sourceIndex int) error {
+	source := s.sources[sourceIndex]
+	hasNext, err := source.Next()
+	if !hasNext || err != nil {
+		return err
+	}
+
+	value, err := source.Value()
+	if err != nil {
+		return err
+	}
+
+	s.heads[sourceIndex] = value
+	s.heap = append(s.heap, sourceIndex)
+	s.up(len(s.heap) - 1)
+	return nil
+}
+
+// heapLess orders sources by their current item, falling back to their index so that equal
+// items are yielded in source order.
+func (s *enumerableMergeSorted[T]) heapLess(i int, j int) bool {
+	a, b := s.heap[i], s.heap[j]
+	if s.less(s.heads[a], s.heads[b]) {
+		return true
+	}
+	if s.less(s.heads[b], s.heads[a]) {
+		return false
+	}
+	return a < b
+}
+
+func (s *enumerableMergeSorted[T]) pop() {
+	last := len(s.heap) - 1
+	s.heap[0] = s.heap[last]
+	s.heap = s.heap[:last]
+	s.down(0)
+}
+
+func (s *enumerableMergeSorted[T]) up(index int) {
+	for index > 0 {
+		parent := (index - 1) / 2
+		if !s.heapLess(index, parent) {
+			return
+		}
+		s.heap[index], s.heap[parent] = s.heap[parent], s.heap[index]
+		index = parent
+	}
+}
+
+func (s *enumerableMergeSorted[T]) down(index int) {
+	for {
+		smallest := index
+		left := 2*index + 1
+		right := left + 1
+		if left < len(s.heap) && s.heapLess(left, smallest) {
+			smallest = left
+		}
+		if right < len(s.heap) && s.heapLess(right, smallest) {
+			smallest = right
+		}
+		if smallest == index {
+			return
+		}
+		s.heap[index], s.heap[smallest] = s.heap[smallest], s.heap[index]
+		index = smallest
+	}
+}
+
+func (s *enumerableMergeSorted[T]) Value() (T, error) {
+	return s.currentValue, nil
+}
+
+func (s *enumerableMergeSorted[T]) Reset() {
+	var zero T
+	for i := range s.heads {
+		s.heads[i] = zero
+	}
+	s.heap = s.heap[:0]
+	s.started = false
+	s.lastSourceIndex = -1
+	for _, source := range s.sources {
+		source.Reset()
+	}
+}
+
+type enumerableMergeJoin[TLeft any, TRight any, K any, TResult any] struct {
+	left             Enumerable[TLeft]
+	right            Enumerable[TRight]
+	leftKeySelector  func(TLeft) (K, error)
+	rightKeySelector func(TRight) (K, error)
+	compare          func(K, K) int
+	resultSelector   func(TLeft, TRight) (TResult, error)
+
+	started bool
+
+	// The current item of each source, and its key, or no value if the source has
+	// been exhausted.
+	//
+	// The right head is the first item after the current run.
+	leftHead  immutable.Option[TLeft]
+	leftKey   K
+	rightHead immutable.Option[TRight]
+	rightKey  K
+
+	// The run of consecutive right items sharing the key of the current left item, or nil
+	// if the current left item has no matches.
+	run    []TRight
+	runKey K
+
+	// The index of the next item in the run to join with the current left item.
+	runIndex int
+
+	currentValue TResult
+}
+
+// MergeJoin creates an `Enumerable` that yields the result of the given result selector for
+// each pair of items from the left and right sources with equal keys.
+//
+// Both sources must already be ordered by key, according to the given compare function,
+// which must return a negative number if a is less than b, a positive number if a is greater
+// than b, and zero if they are equal.
+//
+// Duplicate keys are permitted in both sources, each left item being joined to every right
+// item with an equal key. Only the current run of right items sharing a key is buffered, so
+// that it may be joined to each left item with that key, neither source is otherwise
+// buffered.
+//
+// Both sources are enumerated in a single pass, and are reset on `Reset`.
+func MergeJoin[TLeft any, TRight any, K any, TResult any](
+	left Enumerable[TLeft],
+	right Enumerable[TRight],
+	leftKeySelector func(TLeft) (K, error),
+	rightKeySelector func(TRight) (K, error),
+	compare func(K, K) int,
+	resultSelector func(TLeft, TRight) (TResult, error),
+) Enumerable[TResult] {
+	return &enumerableMergeJoin[TLeft, TRight, K, TResult]{
+		left:             left,
+		right:            right,
+		leftKeySelector:  leftKeySelector,
+		rightKeySelector: rightKeySelector,
+		compare:          compare,
+		resultSelector:   resultSelector,
+	}
+}
+
+func (s *enumerableMergeJoin[TLeft, TRight, K, TResult]) Next() (bool, error) {
+	if !s.started {
+		s.started = true
+		err := s.advanceRight()
+		if err != nil {
+			return false, err
+		}
+	}
+
+	for {
+		if s.runIndex < len(s.run) {
+			result, err := s.resultSelector(s.leftHead.Value(), s.run[s.runIndex])
+			if err != nil {
+				return false, err
+			}
+			s.runIndex++
+			s.currentValue = result
+			return true, nil
+		}
+
+		err := s.advanceLeft()
+		if err != nil {
+			return false, err
+		}
+		if !s.leftHead.HasValue() {
+			return false, nil
+		}
+
+		s.runIndex = 0
+		if s.run != nil && s.compare(s.leftKey, s.runKey) == 0 {
+			// The left item shares the key of the previous one, so the run is joined again.
+			continue
+		}
+		s.run = nil
+
+		for s.rightHead.HasValue() && s.compare(s.rightKey, s.leftKey) < 0 {
+			err = s.advanceRight()
+			if err != nil {
+				return false, err
+			}
+		}
+
+		if !s.rightHead.HasValue() {
+			// No remaining left items can match.
+			return false, nil
+		}
+		if s.compare(s.rightKey, s.leftKey) > 0 {
+			continue
+		}
+
+		s.runKey = s.rightKey
+		for s.rightHead.HasValue() && s.compare(s.rightKey, s.runKey) == 0 {
+			s.run = append(s.run, s.rightHead.Value())
+			err = s.advanceRight()
+			if err != nil {
+				return false, err
+			}
+		}
+	}
+}
+
+func (s *enumerableMergeJoin[TLeft, TRight, K, TResult]) advanceLeft() error {
+	hasNext, err := s.left.Next()
+	if err != nil {
+		return err
+	}
+	if !hasNext {
+		s.leftHead = immutable.None[TLeft]()
+		return nil
+	}
+
+	value, err := s.left.Value()
+	if err != nil {
+		return err
+	}
+
+	key, err := s.leftKeySelector(value)
+	if err != nil {
+		return err
+	}
+
+	s.leftHead = immutable.Some(value)
+	s.leftKey = key
+	return nil
+}
+
+func (s *enumerableMergeJoin[TLeft, TRight, K, TResult]) advanceRight() error {
+	hasNext, err := s.right.Next()
+	if err != nil {
+		return err
+	}
+	if !hasNext {
+		s.rightHead = immutable.None[TRight]()
+		return nil
+	}
+
+	value, err := s.right.Value()
+	if err != nil {
+		return err
+	}
+
+	key, err := s.rightKeySelector(value)
+	if err != nil {
+		return err
+	}
+
+	s.rightHead = immutable.Some(value)
+	s.rightKey = key
+	return nil
+}
+
+func (s *enumerableMergeJoin[TLeft, TRight, K, TResult]) Value() (TResult, error) {
+	return s.currentValue, nil
+}
+
+func (s *enumerableMergeJoin[TLeft, TRight, K, TResult]) Reset() {
+	s.started = false
+	s.leftHead = immutable.None[TLeft]()
+	s.rightHead = immutable.None[TRight]()
+	s.run = nil
+	s.runIndex = 0
+	s.left.Reset()
+	s.right.Reset()
+}
@@ -0,0 +1,177 @@
+package enumerable
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergeSortedYieldsOrderedItemsGivenSortedSources(t *testing.T) {
+	merged := MergeSorted(
+		intLess,
+		New([]int{1, 4, 7}),
+		New([]int{2, 5, 8, 9}),
+		New([]int{}),
+		New([]int{3, 6}),
+	)
+
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, collect(t, merged))
+	// Re-enumeration should yield the same results
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8, 9}, collect(t, merged))
+}
+
+func TestMergeSortedYieldsEqualItemsInSourceOrder(t *testing.T) {
+	type item struct {
+		key    int
+		source string
+	}
+	less := func(a, b item) bool {
+		return a.key < b.key
+	}
+
+	merged := MergeSorted(
+		less,
+		New([]item{{1, "a"}, {2, "a"}}),
+		New([]item{{1, "b"}, {2, "b"}}),
+	)
+
+	require.Equal(
+		t,
+		[]item{{1, "a"}, {1, "b"}, {2, "a"}, {2, "b"}},
+		collect(t, merged),
+	)
+}
+
+func TestMergeSortedYieldsNothingGivenNoSources(t *testing.T) {
+	merged := MergeSorted(intLess)
+
+	require.Empty(t, collect(t, merged))
+}
+
+func TestMergeSortedReturnsErrorGivenSourceError(t *testing.T) {
+	expectedErr := errors.New("test error")
+	source := Where(New([]int{1, 2}), func(v int) (bool, error) {
+		if v == 2 {
+			return false, expectedErr
+		}
+		return true, nil
+	})
+
+	merged := MergeSorted(intLess, New([]int{0, 3}), source)
+
+	var err error
+	for {
+		var hasNext bool
+		hasNext, err = merged.Next()
+		if !hasNext || err != nil {
+			break
+		}
+	}
+	require.ErrorIs(t, err, expectedErr)
+}
+
+func TestMergeJoinYieldsMatchingPairsGivenSortedSources(t *testing.T) {
+	joined := MergeJoin(
+		New([]joinTestDoc{{1, "a"}, {1, "c"}, {3, "b"}, {4, "d"}}),
+		New(joinTestUsers),
+		docOwnerID,
+		userID,
+		compareInts,
+		func(d joinTestDoc, u joinTestUser) (string, error) {
+			return u.name + ":" + d.title, nil
+		},
+	)
+
+	require.Equal(t, []string{"alice:a", "alice:c", "carol:b"}, collect(t, joined))
+	// Re-enumeration should yield the same results
+	require.Equal(t, []string{"alice:a", "alice:c", "carol:b"}, collect(t, joined))
+}
+
+func TestMergeJoinYieldsAllPairsGivenDuplicateKeys(t *testing.T) {
+	joined := MergeJoin(
+		New([]int{1, 2, 2, 3, 4}),
+		New([]int{2, 2, 3, 3, 5}),
+		identity[int],
+		identity[int],
+		compareInts,
+		func(a int, b int) ([2]int, error) {
+			return [2]int{a, b}, nil
+		},
+	)
+
+	expected := [][2]int{{2, 2}, {2, 2}, {2, 2}, {2, 2}, {3, 3}, {3, 3}}
+	require.Equal(t, expected, collect(t, joined))
+	// Re-enumeration should yield the same results
+	require.Equal(t, expected, collect(t, joined))
+}
+
+func TestMergeJoinYieldsAllPairsGivenDuplicateRightKeys(t *testing.T) {
+	type item struct {
+		key   int
+		value string
+	}
+	itemKey := func(i item) (int, error) {
+		return i.key, nil
+	}
+
+	joined := MergeJoin(
+		New([]int{1, 2}),
+		New([]item{{2, "a"}, {2, "b"}}),
+		identity[int],
+		itemKey,
+		compareInts,
+		func(a int, b item) (string, error) {
+			return b.value, nil
+		},
+	)
+
+	require.Equal(t, []string{"a", "b"}, collect(t, joined))
+}
+
+func TestMergeJoinYieldsNothingGivenNoMatchingKeys(t *testing.T) {
+	joined := MergeJoin(
+		New([]int{1, 3, 5}),
+		New([]int{2, 4, 6}),
+		identity[int],
+		identity[int],
+		compareInts,
+		func(a int, b int) (int, error) {
+			return a + b, nil
+		},
+	)
+
+	require.Empty(t, collect(t, joined))
+}
+
+func TestMergeJoinReturnsErrorGivenResultSelectorError(t *testing.T) {
+	expectedErr := errors.New("test error")
+	joined := MergeJoin(
+		New([]int{1, 2}),
+		New([]int{1, 2}),
+		identity[int],
+		identity[int],
+		compareInts,
+		func(a int, b int) (int, error) {
+			return 0, expectedErr
+		},
+	)
+
+	_, err := joined.Next()
+	require.ErrorIs(t, err, expectedErr)
+}
+
+func compareInts(a int, b int) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+func identity[T any](v T) (T, error) {
+	return v, nil
+}
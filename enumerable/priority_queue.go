@@ -0,0 +1,117 @@
+package enumerable
+
+import "github.com/sourcenetwork/immutable"
+
+// PriorityQueue is a `Queue` that yields items in the order determined by a less function,
+// rather than the order in which they were added.
+//
+// Items may be added after enumeration has begun, and will be yielded in priority order
+// relative to the items remaining in the queue.
+type PriorityQueue[T any] interface {
+	Queue[T]
+	// Peek returns the next item to be yielded without removing it from the queue, or no
+	// value if the queue is empty.
+	Peek() immutable.Option[T]
+}
+
+type priorityQueue[T any] struct {
+	// The values of this queue, arranged as a binary min-heap.
+	values []T
+
+	less         func(T, T) bool
+	currentValue T
+}
+
+var _ PriorityQueue[any] = (*priorityQueue[any])(nil)
+
+// NewPriorityQueue creates an empty queue that yields the least item first, using the given
+// less function to determine whether an item is less than another.
+//
+// It is implemented using a binary heap, `Put` and `Next` are O(log n).
+func NewPriorityQueue[T any](less func(T, T) bool) PriorityQueue[T] {
+	return &priorityQueue[T]{
+		values: []T{},
+		less:   less,
+	}
+}
+
+func (q *priorityQueue[T]) Put(value T) error {
+	q.values = append(q.values, value)
+	q.up(len(q.values) - 1)
+	return nil
+}
+
+func (q *priorityQueue[T]) Next() (bool, error) {
+	if len(q.values) == 0 {
+		return false, nil
+	}
+
+	q.currentValue = q.values[0]
+
+	last := len(q.values) - 1
+	q.values[0] = q.values[last]
+	// Clear the vacated slot so that it does not hold a reference to the value.
+	var zero T
+	q.values[last] = zero
+	q.values = q.values[:last]
+	q.down(0)
+
+	return true, nil
+}
+
+func (q *priorityQueue[T]) Value() (T, error) {
+	return q.currentValue, nil
+}
+
+func (q *priorityQueue[T]) Peek() immutable.Option[T] {
+	if len(q.values) == 0 {
+		return immutable.None[T]()
+	}
+	return immutable.Some(q.values[0])
+}
+
+func (q *priorityQueue[T]) Reset() {
+	q.values = []T{}
+}
+
+func (q *priorityQueue[T]) Size() int {
+	return cap(q.values)
+}
+
+func (q *priorityQueue[T]) Len() int {
+	return len(q.values)
+}
+
+// up moves the value at the given index towards the root of the heap until its parent
+// is not greater than it.
+func (q *priorityQueue[T]) up(index int) {
+	for index > 0 {
+		parent := (index - 1) / 2
+		if !q.less(q.values[index], q.values[parent]) {
+			return
+		}
+		q.values[index], q.values[parent] = q.values[parent], q.values[index]
+		index = parent
+	}
+}
+
+// down moves the value at the given index away from the root of the heap until neither
+// of its children are less than it.
+func (q *priorityQueue[T]) down(index int) {
+	for {
+		smallest := index
+		left := 2*index + 1
+		right := left + 1
+		if left < len(q.values) && q.less(q.values[left], q.values[smallest]) {
+			smallest = left
+		}
+		if right < len(q.values) && q.less(q.values[right], q.values[smallest]) {
+			smallest = right
+		}
+		if smallest == index {
+			return
+		}
+		q.values[index], q.values[smallest] = q.values[smallest], q.values[index]
+		index = smallest
+	}
+}
@@ -0,0 +1,69 @@
+package enumerable
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func intLess(a, b int) bool {
+	return a < b
+}
+
+func TestPriorityQueueYieldsNothingGivenEmpty(t *testing.T) {
+	queue := NewPriorityQueue(intLess)
+
+	hasNext, err := queue.Next()
+	require.NoError(t, err)
+	require.False(t, hasNext)
+	require.False(t, queue.Peek().HasValue())
+}
+
+func TestPriorityQueueYieldsItemsInPriorityOrder(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	queue := NewPriorityQueue(intLess)
+
+	expected := []int{}
+	for i := 0; i < 1_000; i++ {
+		v := r.Intn(100)
+		expected = append(expected, v)
+		require.NoError(t, queue.Put(v))
+	}
+	sort.Ints(expected)
+
+	require.Equal(t, 1_000, queue.Len())
+	require.Equal(t, expected, drainQueue[int](t, queue))
+	require.Equal(t, 0, queue.Len())
+}
+
+func TestPriorityQueueYieldsItemsPutAfterEnumerationBegins(t *testing.T) {
+	queue := NewPriorityQueue(intLess)
+	queue.Put(5)
+	queue.Put(3)
+
+	hasNext, err := queue.Next()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+
+	v, err := queue.Value()
+	require.NoError(t, err)
+	require.Equal(t, 3, v)
+
+	queue.Put(4)
+	queue.Put(1)
+
+	require.Equal(t, 1, queue.Peek().Value())
+	require.Equal(t, []int{1, 4, 5}, drainQueue[int](t, queue))
+}
+
+func TestPriorityQueueYieldsNothingGivenReset(t *testing.T) {
+	queue := NewPriorityQueue(intLess)
+	queue.Put(1)
+	queue.Reset()
+
+	hasNext, err := queue.Next()
+	require.NoError(t, err)
+	require.False(t, hasNext)
+}
@@ -14,141 +14,144 @@ type Queue[T any] interface {
 	// This may include empty space where yield items previously resided.
 	// Useful for testing and debugging.
 	Size() int
+	// Len returns the number of items in the queue that are yet to be yielded.
+	Len() int
 }
 
-// For now, increasing the size one at a time is likely optimal
-// for the only useage of the queue type.  We may wish to change
-// this at somepoint however.
-const growthSize int = 1
+// The factor by which the backing array grows when full, and shrinks by when
+// sparsely populated.  Growing geometrically keeps `Put` amortized O(1).
+const growthFactor int = 2
+
+// If shrinking is enabled, the backing array will shrink once the number of items
+// it holds drops to 1/shrinkThreshold of its length.  This is deliberately lower than
+// 1/growthFactor to avoid repeatedly growing and shrinking around the boundary.
+const shrinkThreshold int = 4
 
 type queue[T any] struct {
 	// The values slice of this queue.
 	//
-	// Note: queue is implementated as a dynamically sized ring buffer, the zero index
-	// is not nessecarily the next/current value. Also note that values are not explicitly
-	// removed from this slice, which values are still 'in' the queue is tracked by index.
+	// Note: queue is implementated as a ring buffer, the zero index is not nessecarily
+	// the next value.
 	values []T
 
-	// The index of the current value.
-	currentIndex int
+	// The index of the next value to be yielded.
+	headIndex int
+
+	// The number of values in the queue that are yet to be yielded.
+	count int
 
-	// The index of the last value added to the queue.
-	lastSetIndex int
+	// The value yielded by the last `Next` call.
+	//
+	// It is held outside of the values slice so that its slot may be reused by `Put`
+	// whilst `Value` may still be called multiple times after a single `Next` call.
+	currentValue T
 
-	// Will be true if values[0] has been set.
-	zeroIndexSet bool
+	// The initial length of the values slice, it will not shrink below this.
+	minCapacity int
 
-	// Will be true a value has been attempted to be read from an empty queue.
-	waitingForWrite bool
+	// If true, the values slice will shrink as items are yielded.
+	shrink bool
 }
 
 var _ Queue[any] = (*queue[any])(nil)
 
 // NewQueue creates an empty FIFO queue.
 //
-// It is implemented using a dynamically sized ring-buffer.
+// It is implemented using a dynamically sized ring-buffer, that grows geometrically
+// as items are added.
 func NewQueue[T any]() Queue[T] {
+	return NewQueueWithCapacity[T](0, false)
+}
+
+// NewQueueWithCapacity creates an empty FIFO queue with a backing array of the given
+// initial capacity.
+//
+// If shrink is true, the backing array will shrink, to no less than the initial
+// capacity, as items are yielded. Otherwise it will retain its largest size until reset.
+func NewQueueWithCapacity[T any](capacity int, shrink bool) Queue[T] {
+	if capacity < 0 {
+		capacity = 0
+	}
 	return &queue[T]{
-		values:       []T{},
-		currentIndex: -1,
-		lastSetIndex: -1,
+		values:      make([]T, capacity),
+		minCapacity: capacity,
+		shrink:      shrink,
 	}
 }
 
 func (q *queue[T]) Put(value T) error {
-	index := q.lastSetIndex + 1
-
-	if index >= len(q.values) {
-		if len(q.values) == 0 {
-			q.values = make([]T, growthSize)
-			q.currentIndex = -1
-		} else if q.zeroIndexSet {
-			// If the zero index is occupied, we cannot loop back to it here
-			// and instead need to grow the values slice.
-			newValues := make([]T, len(q.values)+growthSize)
-			copy(newValues, q.values[:index])
-			q.values = newValues
-		} else {
-			index = 0
-			if q.currentIndex >= len(q.values) {
-				q.currentIndex = -1
-			}
+	if q.count == len(q.values) {
+		newLength := len(q.values) * growthFactor
+		if newLength == 0 {
+			newLength = 1
 		}
-	} else if index == q.currentIndex {
-		// If the write index has caught up to the read index
-		// the new value needs to be written between the two
-		// e.g: [3,4,here,1,2]
-		// Note: The last value read should not be overwritten, as `Value`
-		// may be called multiple times on it after a single `Next` call.
-		newValues := make([]T, len(q.values)+growthSize)
-		copy(newValues, q.values[:index])
-		copy(newValues[index+growthSize:], q.values[index:])
-		q.values = newValues
-		// Shift the current read index to reflect its new location.
-		q.currentIndex += growthSize
-	}
-
-	if index == 0 {
-		q.zeroIndexSet = true
+		q.resize(newLength)
 	}
 
-	q.values[index] = value
-	q.lastSetIndex = index
+	q.values[(q.headIndex+q.count)%len(q.values)] = value
+	q.count += 1
 
 	return nil
 }
 
 func (q *queue[T]) Next() (bool, error) {
-	// If the previous index was the zero-index the value is consumed (implicitly), so we update
-	// the flag here.
-	if q.currentIndex == 0 {
-		q.zeroIndexSet = false
+	var zero T
+	if q.count == 0 {
+		// The previously yielded value should not be returned from `Value` once
+		// the end of the queue has been reached.
+		q.currentValue = zero
+		return false, nil
 	}
 
-	nextIndex := q.currentIndex + 1
-	var hasValue bool
-	if nextIndex >= len(q.values) {
-		if q.zeroIndexSet {
-			// Circle back to the beginning
-			nextIndex = 0
-			hasValue = true
-		} else {
-			hasValue = false
-			if q.currentIndex == len(q.values) {
-				// If we have reached the end of the values slice, and the previous
-				// index was already out of bounds, we should avoid growing it further.
-				nextIndex = q.currentIndex
-			}
+	q.currentValue = q.values[q.headIndex]
+	// Clear the slot so that the queue does not hold a reference to the yielded value.
+	q.values[q.headIndex] = zero
+	q.headIndex = (q.headIndex + 1) % len(q.values)
+	q.count -= 1
+
+	if q.shrink {
+		newLength := len(q.values) / growthFactor
+		if q.count <= len(q.values)/shrinkThreshold && newLength >= q.minCapacity {
+			q.resize(newLength)
 		}
-	} else {
-		// If the previous read index was the last index written to then the value has been
-		// consumed and we have reached the edge of the ring: [v2, v3,^we are here, , v1]
-		hasValue = q.currentIndex != q.lastSetIndex
 	}
 
-	q.currentIndex = nextIndex
-	q.waitingForWrite = !hasValue
-	return hasValue, nil
+	return true, nil
 }
 
 func (q *queue[T]) Value() (T, error) {
-	// The read index might be out of bounds at this point (either outside the slice, or the ring)
-	// and we should not return a value here if that is the case.
-	if q.waitingForWrite {
-		var zero T
-		return zero, nil
-	}
-	return q.values[q.currentIndex], nil
+	return q.currentValue, nil
 }
 
 func (q *queue[T]) Reset() {
-	q.values = []T{}
-	q.currentIndex = -1
-	q.lastSetIndex = -1
-	q.zeroIndexSet = false
-	q.waitingForWrite = false
+	var zero T
+	q.values = make([]T, q.minCapacity)
+	q.headIndex = 0
+	q.count = 0
+	q.currentValue = zero
 }
 
 func (q *queue[T]) Size() int {
 	return len(q.values)
 }
+
+func (q *queue[T]) Len() int {
+	return q.count
+}
+
+// resize replaces the values slice with one of the given length, moving the values
+// yet to be yielded to the start of it.
+func (q *queue[T]) resize(length int) {
+	newValues := make([]T, length)
+	if q.count > 0 {
+		tailIndex := q.headIndex + q.count
+		if tailIndex <= len(q.values) {
+			copy(newValues, q.values[q.headIndex:tailIndex])
+		} else {
+			n := copy(newValues, q.values[q.headIndex:])
+			copy(newValues[n:], q.values[:tailIndex-len(q.values)])
+		}
+	}
+	q.values = newValues
+	q.headIndex = 0
+}
@@ -1,6 +1,7 @@
 package enumerable
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -176,14 +177,14 @@ func TestQueueYieldsItemCorrectlyGivenCircle(t *testing.T) {
 	queue.Put(v1)
 	queue.Put(v2)
 	queue.Put(v3)
-	// [1, 2, 3]
+	// [1, 2, 3, ]
 
 	hasNext, err := queue.Next()
 	require.NoError(t, err)
 	require.True(t, hasNext)
 
 	r1, err := queue.Value()
-	// [1, 2, 3]
+	// [, 2, 3, ]
 	require.NoError(t, err)
 	require.Equal(t, v1, r1)
 
@@ -192,19 +193,19 @@ func TestQueueYieldsItemCorrectlyGivenCircle(t *testing.T) {
 	require.True(t, hasNext)
 
 	r2, err := queue.Value()
-	// [, 2, 3]
+	// [, , 3, ]
 	require.NoError(t, err)
 	require.Equal(t, v2, r2)
 
 	queue.Put(v4)
-	// [4, 2, 3]
+	// [, , 3, 4]
 
 	hasNext, err = queue.Next()
 	require.NoError(t, err)
 	require.True(t, hasNext)
 
 	r3, err := queue.Value()
-	// [4, , 3]
+	// [, , , 4]
 	require.NoError(t, err)
 	require.Equal(t, v3, r3)
 
@@ -213,7 +214,7 @@ func TestQueueYieldsItemCorrectlyGivenCircle(t *testing.T) {
 	require.True(t, hasNext)
 
 	r4, err := queue.Value()
-	// [4, ,]
+	// [, , , ]
 	require.NoError(t, err)
 	require.Equal(t, v4, r4)
 
@@ -222,7 +223,7 @@ func TestQueueYieldsItemCorrectlyGivenCircle(t *testing.T) {
 	require.False(t, hasNext)
 
 	size := queue.Size()
-	require.Equal(t, 3, size)
+	require.Equal(t, 4, size)
 }
 
 func TestQueuePutsItemCorrectlyGivenCircle(t *testing.T) {
@@ -237,14 +238,14 @@ func TestQueuePutsItemCorrectlyGivenCircle(t *testing.T) {
 	queue.Put(v1)
 	queue.Put(v2)
 	queue.Put(v3)
-	// [1, 2, 3]
+	// [1, 2, 3, ]
 
 	hasNext, err := queue.Next()
 	require.NoError(t, err)
 	require.True(t, hasNext)
 
 	r1, err := queue.Value()
-	// [1, 2, 3]
+	// [, 2, 3, ]
 	require.NoError(t, err)
 	require.Equal(t, v1, r1)
 
@@ -253,21 +254,21 @@ func TestQueuePutsItemCorrectlyGivenCircle(t *testing.T) {
 	require.True(t, hasNext)
 
 	r2, err := queue.Value()
-	// [, 2, 3]
+	// [, , 3, ]
 	require.NoError(t, err)
 	require.Equal(t, v2, r2)
 
 	queue.Put(v4)
 	queue.Put(v5)
 	queue.Put(v6)
-	// [4, 5, 6, 2, 3]
+	// [5, 6, 3, 4]
 
 	hasNext, err = queue.Next()
 	require.NoError(t, err)
 	require.True(t, hasNext)
 
 	r3, err := queue.Value()
-	// [4, 5, 6, , 3]
+	// [5, 6, , 4]
 	require.NoError(t, err)
 	require.Equal(t, v3, r3)
 
@@ -276,7 +277,7 @@ func TestQueuePutsItemCorrectlyGivenCircle(t *testing.T) {
 	require.True(t, hasNext)
 
 	r4, err := queue.Value()
-	// [, 5, 6, , 3]
+	// [5, 6, , ]
 	require.NoError(t, err)
 	require.Equal(t, v4, r4)
 
@@ -285,7 +286,7 @@ func TestQueuePutsItemCorrectlyGivenCircle(t *testing.T) {
 	require.True(t, hasNext)
 
 	r5, err := queue.Value()
-	// [, 5, 6, , ]
+	// [, 6, , ]
 	require.NoError(t, err)
 	require.Equal(t, v5, r5)
 
@@ -294,7 +295,7 @@ func TestQueuePutsItemCorrectlyGivenCircle(t *testing.T) {
 	require.True(t, hasNext)
 
 	r6, err := queue.Value()
-	// [, , 6, , ]
+	// [, , , ]
 	require.NoError(t, err)
 	require.Equal(t, v6, r6)
 
@@ -303,7 +304,7 @@ func TestQueuePutsItemCorrectlyGivenCircle(t *testing.T) {
 	require.False(t, hasNext)
 
 	size := queue.Size()
-	require.Equal(t, 5, size)
+	require.Equal(t, 4, size)
 }
 
 func TestQueueYieldsItemAddedAfterFullEnumeration(t *testing.T) {
@@ -362,3 +363,136 @@ func TestQueueReturnsFalseAfterYieldingItemAddedAfterInitialEnumerations(t *test
 	require.NoError(t, err)
 	require.False(t, hasNext)
 }
+
+func TestQueueLenReturnsNumberOfItemsYetToBeYielded(t *testing.T) {
+	queue := NewQueue[int]()
+	require.Equal(t, 0, queue.Len())
+
+	queue.Put(1)
+	queue.Put(2)
+	queue.Put(3)
+	require.Equal(t, 3, queue.Len())
+
+	queue.Next()
+	require.Equal(t, 2, queue.Len())
+
+	queue.Next()
+	queue.Next()
+	require.Equal(t, 0, queue.Len())
+
+	hasNext, err := queue.Next()
+	require.NoError(t, err)
+	require.False(t, hasNext)
+	require.Equal(t, 0, queue.Len())
+
+	queue.Put(4)
+	require.Equal(t, 1, queue.Len())
+
+	queue.Reset()
+	require.Equal(t, 0, queue.Len())
+}
+
+func TestQueueWithCapacityDoesNotGrowGivenItemsWithinCapacity(t *testing.T) {
+	queue := NewQueueWithCapacity[int](8, false)
+	require.Equal(t, 8, queue.Size())
+
+	for i := 1; i <= 8; i++ {
+		queue.Put(i)
+	}
+	require.Equal(t, 8, queue.Size())
+
+	queue.Put(9)
+	require.Equal(t, 16, queue.Size())
+}
+
+func TestQueueWithCapacityShrinksAfterDrain(t *testing.T) {
+	queue := NewQueueWithCapacity[int](4, true)
+
+	for i := 1; i <= 100; i++ {
+		queue.Put(i)
+	}
+	require.Equal(t, 128, queue.Size())
+
+	for i := 1; i <= 100; i++ {
+		hasNext, err := queue.Next()
+		require.NoError(t, err)
+		require.True(t, hasNext)
+
+		r, err := queue.Value()
+		require.NoError(t, err)
+		require.Equal(t, i, r)
+	}
+	require.Equal(t, 4, queue.Size())
+}
+
+func TestQueueWithCapacityYieldsItemsGivenShrinkWhilstWrapped(t *testing.T) {
+	queue := NewQueueWithCapacity[int](0, true)
+
+	expected := []int{}
+	results := []int{}
+	for i := 0; i < 1_000; i++ {
+		queue.Put(i)
+		expected = append(expected, i)
+		if i%3 == 0 {
+			continue
+		}
+		hasNext, err := queue.Next()
+		require.NoError(t, err)
+		require.True(t, hasNext)
+
+		r, err := queue.Value()
+		require.NoError(t, err)
+		results = append(results, r)
+	}
+
+	results = append(results, drainQueue(t, queue)...)
+	require.Equal(t, expected, results)
+	require.Equal(t, 1, queue.Size())
+}
+
+func BenchmarkQueuePut(b *testing.B) {
+	queue := NewQueue[int]()
+	for i := 0; i < b.N; i++ {
+		queue.Put(i)
+	}
+}
+
+// BenchmarkQueueInterleaved puts and yields items with the consumer lagging behind
+// the producer by the given number of items. The cost per operation should not grow
+// with the lag.
+func BenchmarkQueueInterleaved(b *testing.B) {
+	for _, lag := range []int{10, 1_000, 100_000} {
+		b.Run(fmt.Sprintf("lag=%d", lag), func(b *testing.B) {
+			queue := NewQueue[int]()
+			for i := 0; i < lag; i++ {
+				queue.Put(i)
+			}
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				queue.Put(i)
+				queue.Next()
+				queue.Value()
+			}
+		})
+	}
+}
+
+// BenchmarkQueueBursts puts bursts of items into the queue, then drains them.
+func BenchmarkQueueBursts(b *testing.B) {
+	for _, shrink := range []bool{false, true} {
+		b.Run(fmt.Sprintf("shrink=%v", shrink), func(b *testing.B) {
+			queue := NewQueueWithCapacity[int](0, shrink)
+			const burstSize = 1_000
+
+			for i := 0; i < b.N; i++ {
+				queue.Put(i)
+				if i%burstSize == 0 {
+					for queue.Len() > 0 {
+						queue.Next()
+					}
+				}
+			}
+		})
+	}
+}
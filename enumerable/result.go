@@ -0,0 +1,106 @@
+package enumerable
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sourcenetwork/immutable"
+)
+
+type enumerableToResults[T any] struct {
+	source       Enumerable[T]
+	currentValue immutable.Result[T]
+
+	// True if the source's context is done and enumeration should end once the current
+	// (failed) result has been yielded.
+	done bool
+}
+
+// ToResults creates an `Enumerable` that yields the items of the given source wrapped
+// in `immutable.Result`s.
+//
+// Any errors returned by the source are yielded as failed results instead of halting
+// the enumeration, allowing the rest of the source to be processed.
+//
+// Enumeration ends after yielding a failed result if the error is a context cancellation
+// or deadline error. Any other error is assumed to be specific to the current item, so a
+// source that cannot progress past an error must return a context error, otherwise the
+// returned `Enumerable` will never end.
+func ToResults[T any](source Enumerable[T]) Enumerable[immutable.Result[T]] {
+	return &enumerableToResults[T]{
+		source: source,
+	}
+}
+
+func (s *enumerableToResults[T]) Next() (bool, error) {
+	if s.done {
+		return false, nil
+	}
+
+	hasNext, err := s.source.Next()
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			s.done = true
+		}
+		s.currentValue = immutable.Err[T](err)
+		return true, nil
+	}
+	if !hasNext {
+		return false, nil
+	}
+
+	s.currentValue = immutable.ResultFrom(s.source.Value())
+	return true, nil
+}
+
+func (s *enumerableToResults[T]) Value() (immutable.Result[T], error) {
+	return s.currentValue, nil
+}
+
+func (s *enumerableToResults[T]) Reset() {
+	s.done = false
+	s.source.Reset()
+}
+
+type enumerableFromResults[T any] struct {
+	source       Enumerable[immutable.Result[T]]
+	currentValue T
+}
+
+// FromResults creates an `Enumerable` that yields the values of the `immutable.Result`s
+// in the given source.
+//
+// If a failed result is encountered, its error will be returned from `Next`.
+func FromResults[T any](source Enumerable[immutable.Result[T]]) Enumerable[T] {
+	return &enumerableFromResults[T]{
+		source: source,
+	}
+}
+
+func (s *enumerableFromResults[T]) Next() (bool, error) {
+	hasNext, err := s.source.Next()
+	if !hasNext || err != nil {
+		return false, err
+	}
+
+	result, err := s.source.Value()
+	if err != nil {
+		return false, err
+	}
+
+	value, err := result.Unwrap()
+	if err != nil {
+		return false, err
+	}
+
+	s.currentValue = value
+	return true, nil
+}
+
+func (s *enumerableFromResults[T]) Value() (T, error) {
+	return s.currentValue, nil
+}
+
+func (s *enumerableFromResults[T]) Reset() {
+	s.source.Reset()
+}
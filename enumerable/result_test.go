@@ -0,0 +1,107 @@
+package enumerable
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+)
+
+func TestToResultsContinuesPastErrors(t *testing.T) {
+	expectedErr := errors.New("test error")
+	source := Where(New([]int{1, 2, 3}), func(v int) (bool, error) {
+		if v == 2 {
+			return false, expectedErr
+		}
+		return true, nil
+	})
+
+	results := []immutable.Result[int]{}
+	err := ForEach(ToResults(source), func(r immutable.Result[int]) {
+		results = append(results, r)
+	})
+	require.NoError(t, err)
+
+	require.Equal(
+		t,
+		[]immutable.Result[int]{
+			immutable.Ok(1),
+			immutable.Err[int](expectedErr),
+			immutable.Ok(3),
+		},
+		results,
+	)
+}
+
+func TestToResultsEndsGivenCancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	source := make(chan int, 1)
+	source <- 1
+
+	results := []immutable.Result[int]{}
+	err := ForEach(ToResults(FromChan(ctx, source)), func(r immutable.Result[int]) {
+		results = append(results, r)
+	})
+	require.NoError(t, err)
+
+	require.Len(t, results, 1)
+	require.ErrorIs(t, results[0].Err(), context.Canceled)
+}
+
+func TestToResultsContinuesPastConsecutiveErrors(t *testing.T) {
+	expectedErr := errors.New("test error")
+	source := Where(New([]int{1, 2, 3, 4}), func(v int) (bool, error) {
+		if v <= 2 {
+			return false, expectedErr
+		}
+		return true, nil
+	})
+
+	results := []immutable.Result[int]{}
+	err := ForEach(ToResults(source), func(r immutable.Result[int]) {
+		results = append(results, r)
+	})
+	require.NoError(t, err)
+
+	require.Equal(
+		t,
+		[]immutable.Result[int]{
+			immutable.Err[int](expectedErr),
+			immutable.Err[int](expectedErr),
+			immutable.Ok(3),
+			immutable.Ok(4),
+		},
+		results,
+	)
+}
+
+func TestFromResultsYieldsValues(t *testing.T) {
+	source := New([]immutable.Result[int]{immutable.Ok(1), immutable.Ok(2)})
+
+	results := []int{}
+	err := ForEach(FromResults(source), func(v int) {
+		results = append(results, v)
+	})
+	require.NoError(t, err)
+	require.Equal(t, []int{1, 2}, results)
+}
+
+func TestFromResultsReturnsErrorGivenFailedResult(t *testing.T) {
+	expectedErr := errors.New("test error")
+	source := FromResults(New([]immutable.Result[int]{immutable.Ok(1), immutable.Err[int](expectedErr)}))
+
+	hasNext, err := source.Next()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+
+	v, err := source.Value()
+	require.NoError(t, err)
+	require.Equal(t, 1, v)
+
+	hasNext, err = source.Next()
+	require.ErrorIs(t, err, expectedErr)
+	require.False(t, hasNext)
+}
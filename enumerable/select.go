@@ -1,5 +1,7 @@
 package enumerable
 
+import "context"
+
 type enumerableSelect[TSource any, TResult any] struct {
 	source       Enumerable[TSource]
 	selector     func(TSource) (TResult, error)
@@ -47,3 +49,54 @@ func (s *enumerableSelect[TSource, TResult]) Value() (TResult, error) {
 func (s *enumerableSelect[TSource, TResult]) Reset() {
 	s.source.Reset()
 }
+
+type contextSelect[TSource any, TResult any] struct {
+	source       ContextEnumerable[TSource]
+	selector     func(TSource) (TResult, error)
+	currentValue TResult
+}
+
+// SelectContext is the `ContextEnumerable` equivalent of `Select`.
+//
+// The context is checked before each item is evaluated.
+func SelectContext[TSource any, TResult any](
+	source ContextEnumerable[TSource],
+	selector func(TSource) (TResult, error),
+) ContextEnumerable[TResult] {
+	return &contextSelect[TSource, TResult]{
+		source:   source,
+		selector: selector,
+	}
+}
+
+func (s *contextSelect[TSource, TResult]) Next(ctx context.Context) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	hasNext, err := s.source.Next(ctx)
+	if !hasNext || err != nil {
+		return hasNext, err
+	}
+
+	value, err := s.source.Value()
+	if err != nil {
+		return false, err
+	}
+
+	result, err := s.selector(value)
+	if err != nil {
+		return false, err
+	}
+
+	s.currentValue = result
+	return true, nil
+}
+
+func (s *contextSelect[TSource, TResult]) Value() (TResult, error) {
+	return s.currentValue, nil
+}
+
+func (s *contextSelect[TSource, TResult]) Reset() {
+	s.source.Reset()
+}
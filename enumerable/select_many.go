@@ -0,0 +1,72 @@
+package enumerable
+
+type enumerableSelectMany[TSource any, TResult any] struct {
+	source   Enumerable[TSource]
+	selector func(TSource) (Enumerable[TResult], error)
+
+	// The inner enumerable created from the current source item, or nil if there is none.
+	current Enumerable[TResult]
+}
+
+// SelectMany creates an `Enumerable` that yields the items of each `Enumerable` returned by
+// the given selector, for each item in the source `Enumerable`.
+//
+// The selector is only called once the items of the previous inner `Enumerable` have been
+// yielded. On `Reset` both the source and any inner `Enumerable` still being enumerated are
+// reset.
+func SelectMany[TSource any, TResult any](
+	source Enumerable[TSource],
+	selector func(TSource) (Enumerable[TResult], error),
+) Enumerable[TResult] {
+	return &enumerableSelectMany[TSource, TResult]{
+		source:   source,
+		selector: selector,
+	}
+}
+
+func (s *enumerableSelectMany[TSource, TResult]) Next() (bool, error) {
+	for {
+		if s.current != nil {
+			hasNext, err := s.current.Next()
+			if hasNext || err != nil {
+				return hasNext, err
+			}
+			// The inner enumerable is reset once exhausted, so that the selector may return
+			// the same enumerable for more than one source item.
+			s.current.Reset()
+			s.current = nil
+		}
+
+		hasNext, err := s.source.Next()
+		if !hasNext || err != nil {
+			return hasNext, err
+		}
+
+		value, err := s.source.Value()
+		if err != nil {
+			return false, err
+		}
+
+		inner, err := s.selector(value)
+		if err != nil {
+			return false, err
+		}
+		s.current = inner
+	}
+}
+
+func (s *enumerableSelectMany[TSource, TResult]) Value() (TResult, error) {
+	if s.current == nil {
+		var zero TResult
+		return zero, nil
+	}
+	return s.current.Value()
+}
+
+func (s *enumerableSelectMany[TSource, TResult]) Reset() {
+	if s.current != nil {
+		s.current.Reset()
+		s.current = nil
+	}
+	s.source.Reset()
+}
@@ -0,0 +1,57 @@
+package enumerable
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectManyYieldsFlattenedItems(t *testing.T) {
+	flattened := SelectMany(New([]int{1, 0, 2, 3}), func(v int) (Enumerable[int], error) {
+		items := make([]int, v)
+		for i := range items {
+			items[i] = v
+		}
+		return New(items), nil
+	})
+
+	require.Equal(t, []int{1, 2, 2, 3, 3, 3}, collect(t, flattened))
+	// Re-enumeration should yield the same results
+	require.Equal(t, []int{1, 2, 2, 3, 3, 3}, collect(t, flattened))
+}
+
+func TestSelectManyYieldsItemsGivenSharedInnerEnumerable(t *testing.T) {
+	inner := New([]string{"a", "b"})
+	flattened := SelectMany(New([]int{1, 2}), func(v int) (Enumerable[string], error) {
+		return inner, nil
+	})
+
+	require.Equal(t, []string{"a", "b", "a", "b"}, collect(t, flattened))
+}
+
+func TestSelectManyResetsInFlightInnerEnumerable(t *testing.T) {
+	inner := New([]int{1, 2, 3})
+	flattened := SelectMany(New([]int{0}), func(v int) (Enumerable[int], error) {
+		return inner, nil
+	})
+
+	hasNext, err := flattened.Next()
+	require.NoError(t, err)
+	require.True(t, hasNext)
+
+	flattened.Reset()
+
+	require.Equal(t, []int{1, 2, 3}, collect(t, inner))
+	require.Equal(t, []int{1, 2, 3}, collect(t, flattened))
+}
+
+func TestSelectManyReturnsErrorGivenSelectorError(t *testing.T) {
+	expectedErr := errors.New("test error")
+	flattened := SelectMany(New([]int{1}), func(v int) (Enumerable[int], error) {
+		return nil, expectedErr
+	})
+
+	_, err := flattened.Next()
+	require.ErrorIs(t, err, expectedErr)
+}
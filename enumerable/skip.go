@@ -1,5 +1,7 @@
 package enumerable
 
+import "context"
+
 type enumerableSkip[T any] struct {
 	source Enumerable[T]
 	offset uint64
@@ -36,3 +38,46 @@ func (s *enumerableSkip[T]) Reset() {
 	s.count = 0
 	s.source.Reset()
 }
+
+type contextSkip[T any] struct {
+	source ContextEnumerable[T]
+	offset uint64
+	count  uint64
+}
+
+// SkipContext is the `ContextEnumerable` equivalent of `Skip`.
+//
+// The context is checked before each item is evaluated, including those skipped.
+func SkipContext[T any](source ContextEnumerable[T], offset uint64) ContextEnumerable[T] {
+	return &contextSkip[T]{
+		source: source,
+		offset: offset,
+	}
+}
+
+func (s *contextSkip[T]) Next(ctx context.Context) (bool, error) {
+	for s.count < s.offset {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+		s.count += 1
+		hasNext, err := s.source.Next(ctx)
+		if !hasNext || err != nil {
+			return hasNext, err
+		}
+	}
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	s.count += 1
+	return s.source.Next(ctx)
+}
+
+func (s *contextSkip[T]) Value() (T, error) {
+	return s.source.Value()
+}
+
+func (s *contextSkip[T]) Reset() {
+	s.count = 0
+	s.source.Reset()
+}
@@ -1,6 +1,9 @@
 package enumerable
 
-import "sort"
+import (
+	"context"
+	"sort"
+)
 
 type enumerableSort[T any] struct {
 	source   Enumerable[T]
@@ -26,43 +29,9 @@ func Sort[T any](source Enumerable[T], less func(T, T) bool, capacity int) Enume
 
 func (s *enumerableSort[T]) Next() (bool, error) {
 	if s.result == nil {
-		result := make([]T, 0, s.capacity)
-		var searchErr error
-
-		// Declaring an anonymous function costs, so we do it here outside of the loop
-		// even though it is slightly less intuitive
-		f := func(i int) bool {
-			var val T
-			val, searchErr = s.source.Value()
-			return !s.less(result[i], val)
-		}
-
-		for i := 0; i <= s.capacity; i++ {
-			hasNext, err := s.source.Next()
-			if err != nil {
-				return false, err
-			}
-			if !hasNext {
-				break
-			}
-
-			previousLength := len(result)
-			indexOfFirstGreaterValue := sort.Search(previousLength, f)
-			if searchErr != nil {
-				// This is quite ugly, but sort.Search does not allow for anything else
-				return false, searchErr
-			}
-
-			value, err := s.source.Value()
-			result = append(result, value)
-			if indexOfFirstGreaterValue == previousLength {
-				// Value is the greatest, and belongs at the end
-				continue
-			}
-			// Shift all items to the right of the first element of greater value by
-			// one place.  This call should not allocate.
-			copy(result[indexOfFirstGreaterValue+1:], result[indexOfFirstGreaterValue:])
-			result[indexOfFirstGreaterValue] = value
+		result, err := sortSource(s.source, s.less, s.capacity)
+		if err != nil {
+			return false, err
 		}
 
 		// Use the enumerableSlice for convienience
@@ -83,3 +52,92 @@ func (s *enumerableSort[T]) Reset() {
 	s.result = nil
 	s.source.Reset()
 }
+
+// sortSource enumerates the given source, returning its items in the order determined by
+// the given less function.
+func sortSource[T any](source Enumerable[T], less func(T, T) bool, capacity int) ([]T, error) {
+	result := make([]T, 0, capacity)
+	var searchErr error
+
+	// Declaring an anonymous function costs, so we do it here outside of the loop
+	// even though it is slightly less intuitive
+	f := func(i int) bool {
+		var val T
+		val, searchErr = source.Value()
+		return !less(result[i], val)
+	}
+
+	for i := 0; i <= capacity; i++ {
+		hasNext, err := source.Next()
+		if err != nil {
+			return nil, err
+		}
+		if !hasNext {
+			break
+		}
+
+		previousLength := len(result)
+		indexOfFirstGreaterValue := sort.Search(previousLength, f)
+		if searchErr != nil {
+			// This is quite ugly, but sort.Search does not allow for anything else
+			return nil, searchErr
+		}
+
+		value, err := source.Value()
+		result = append(result, value)
+		if indexOfFirstGreaterValue == previousLength {
+			// Value is the greatest, and belongs at the end
+			continue
+		}
+		// Shift all items to the right of the first element of greater value by
+		// one place.  This call should not allocate.
+		copy(result[indexOfFirstGreaterValue+1:], result[indexOfFirstGreaterValue:])
+		result[indexOfFirstGreaterValue] = value
+	}
+
+	return result, nil
+}
+
+type contextSort[T any] struct {
+	source   ContextEnumerable[T]
+	less     func(T, T) bool
+	capacity int
+	result   Enumerable[T]
+}
+
+// SortContext is the `ContextEnumerable` equivalent of `Sort`.
+//
+// The context is checked before each item is evaluated, both whilst enumerating the
+// source and whilst yielding the sorted items.
+func SortContext[T any](source ContextEnumerable[T], less func(T, T) bool, capacity int) ContextEnumerable[T] {
+	return &contextSort[T]{
+		source:   source,
+		less:     less,
+		capacity: capacity,
+	}
+}
+
+func (s *contextSort[T]) Next(ctx context.Context) (bool, error) {
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+
+	if s.result == nil {
+		result, err := sortSource(BindContext(ctx, s.source), s.less, s.capacity)
+		if err != nil {
+			return false, err
+		}
+		s.result = New(result)
+	}
+
+	return s.result.Next()
+}
+
+func (s *contextSort[T]) Value() (T, error) {
+	return s.result.Value()
+}
+
+func (s *contextSort[T]) Reset() {
+	s.result = nil
+	s.source.Reset()
+}
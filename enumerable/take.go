@@ -1,5 +1,7 @@
 package enumerable
 
+import "context"
+
 type enumerableTake[T any] struct {
 	source Enumerable[T]
 	limit  uint64
@@ -31,3 +33,39 @@ func (s *enumerableTake[T]) Reset() {
 	s.count = 0
 	s.source.Reset()
 }
+
+type contextTake[T any] struct {
+	source ContextEnumerable[T]
+	limit  uint64
+	count  uint64
+}
+
+// TakeContext is the `ContextEnumerable` equivalent of `Take`.
+//
+// The context is checked before each item is evaluated.
+func TakeContext[T any](source ContextEnumerable[T], limit uint64) ContextEnumerable[T] {
+	return &contextTake[T]{
+		source: source,
+		limit:  limit,
+	}
+}
+
+func (s *contextTake[T]) Next(ctx context.Context) (bool, error) {
+	if s.count == s.limit {
+		return false, nil
+	}
+	if err := ctx.Err(); err != nil {
+		return false, err
+	}
+	s.count += 1
+	return s.source.Next(ctx)
+}
+
+func (s *contextTake[T]) Value() (T, error) {
+	return s.source.Value()
+}
+
+func (s *contextTake[T]) Reset() {
+	s.count = 0
+	s.source.Reset()
+}
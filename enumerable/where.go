@@ -1,5 +1,7 @@
 package enumerable
 
+import "context"
+
 type enumerableWhere[T any] struct {
 	source    Enumerable[T]
 	predicate func(T) (bool, error)
@@ -40,3 +42,48 @@ func (s *enumerableWhere[T]) Value() (T, error) {
 func (s *enumerableWhere[T]) Reset() {
 	s.source.Reset()
 }
+
+type contextWhere[T any] struct {
+	source    ContextEnumerable[T]
+	predicate func(T) (bool, error)
+}
+
+// WhereContext is the `ContextEnumerable` equivalent of `Where`.
+//
+// The context is checked before each item is evaluated.
+func WhereContext[T any](source ContextEnumerable[T], predicate func(T) (bool, error)) ContextEnumerable[T] {
+	return &contextWhere[T]{
+		source:    source,
+		predicate: predicate,
+	}
+}
+
+func (s *contextWhere[T]) Next(ctx context.Context) (bool, error) {
+	for {
+		if err := ctx.Err(); err != nil {
+			return false, err
+		}
+
+		hasNext, err := s.source.Next(ctx)
+		if !hasNext || err != nil {
+			return hasNext, err
+		}
+
+		value, err := s.source.Value()
+		if err != nil {
+			return false, err
+		}
+
+		if passes, err := s.predicate(value); passes || err != nil {
+			return passes, err
+		}
+	}
+}
+
+func (s *contextWhere[T]) Value() (T, error) {
+	return s.source.Value()
+}
+
+func (s *contextWhere[T]) Reset() {
+	s.source.Reset()
+}
@@ -0,0 +1,127 @@
+package enumerable
+
+import "github.com/sourcenetwork/immutable"
+
+type enumerableZip[A any, B any, R any] struct {
+	a Enumerable[A]
+	b Enumerable[B]
+
+	// The result selector, given no value for the side that has been exhausted if
+	// `longest` is true.
+	resultSelector func(immutable.Option[A], immutable.Option[B]) (R, error)
+
+	// If true enumeration continues until both sources are exhausted, otherwise it stops
+	// as soon as either source is exhausted.
+	longest bool
+
+	currentValue R
+}
+
+// Zip creates an `Enumerable` that yields the result of the given result selector for each
+// pair of items at the same position in the two given sources.
+//
+// Enumeration stops as soon as either source is exhausted. Both sources are reset on `Reset`.
+func Zip[A any, B any, R any](a Enumerable[A], b Enumerable[B], resultSelector func(A, B) (R, error)) Enumerable[R] {
+	return &enumerableZip[A, B, R]{
+		a: a,
+		b: b,
+		resultSelector: func(a immutable.Option[A], b immutable.Option[B]) (R, error) {
+			return resultSelector(a.Value(), b.Value())
+		},
+	}
+}
+
+// ZipPairs creates an `Enumerable` that yields a `Pair` for each pair of items at the same
+// position in the two given sources.
+//
+// Enumeration stops as soon as either source is exhausted. Both sources are reset on `Reset`.
+func ZipPairs[A any, B any](a Enumerable[A], b Enumerable[B]) Enumerable[immutable.Pair[A, B]] {
+	return Zip(a, b, func(a A, b B) (immutable.Pair[A, B], error) {
+		return immutable.NewPair(a, b), nil
+	})
+}
+
+// ZipLongest creates an `Enumerable` that yields the result of the given result selector for
+// each pair of items at the same position in the two given sources.
+//
+// Enumeration continues until both sources are exhausted, with the result selector given no
+// value for the side that ran out first. Both sources are reset on `Reset`.
+func ZipLongest[A any, B any, R any](
+	a Enumerable[A],
+	b Enumerable[B],
+	resultSelector func(immutable.Option[A], immutable.Option[B]) (R, error),
+) Enumerable[R] {
+	return &enumerableZip[A, B, R]{
+		a:              a,
+		b:              b,
+		resultSelector: resultSelector,
+		longest:        true,
+	}
+}
+
+// ZipLongestPairs creates an `Enumerable` that yields a `Pair` for each pair of items at the
+// same position in the two given sources.
+//
+// Enumeration continues until both sources are exhausted, with no value held for the side
+// that ran out first. Both sources are reset on `Reset`.
+func ZipLongestPairs[A any, B any](
+	a Enumerable[A],
+	b Enumerable[B],
+) Enumerable[immutable.Pair[immutable.Option[A], immutable.Option[B]]] {
+	return ZipLongest(
+		a,
+		b,
+		func(a immutable.Option[A], b immutable.Option[B]) (immutable.Pair[immutable.Option[A], immutable.Option[B]], error) {
+			return immutable.NewPair(a, b), nil
+		},
+	)
+}
+
+func (s *enumerableZip[A, B, R]) Next() (bool, error) {
+	a, err := nextOption(s.a)
+	if err != nil {
+		return false, err
+	}
+	if !a.HasValue() && !s.longest {
+		return false, nil
+	}
+
+	b, err := nextOption(s.b)
+	if err != nil {
+		return false, err
+	}
+	if !b.HasValue() && (!s.longest || !a.HasValue()) {
+		return false, nil
+	}
+
+	result, err := s.resultSelector(a, b)
+	if err != nil {
+		return false, err
+	}
+	s.currentValue = result
+	return true, nil
+}
+
+// nextOption moves the given source to its next item and returns it, or no value if the source
+// has been exhausted.
+func nextOption[T any](source Enumerable[T]) (immutable.Option[T], error) {
+	hasNext, err := source.Next()
+	if !hasNext || err != nil {
+		return immutable.None[T](), err
+	}
+
+	value, err := source.Value()
+	if err != nil {
+		return immutable.None[T](), err
+	}
+	return immutable.Some(value), nil
+}
+
+func (s *enumerableZip[A, B, R]) Value() (R, error) {
+	return s.currentValue, nil
+}
+
+func (s *enumerableZip[A, B, R]) Reset() {
+	s.a.Reset()
+	s.b.Reset()
+}
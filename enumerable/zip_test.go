@@ -0,0 +1,75 @@
+package enumerable
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/sourcenetwork/immutable"
+	"github.com/stretchr/testify/require"
+)
+
+func TestZipYieldsResultsUntilShorterSourceIsExhausted(t *testing.T) {
+	zipped := Zip(
+		New([]int{1, 2, 3}),
+		New([]string{"a", "b"}),
+		func(a int, b string) (string, error) {
+			return strconv.Itoa(a) + b, nil
+		},
+	)
+
+	require.Equal(t, []string{"1a", "2b"}, collect(t, zipped))
+	// Re-enumeration should yield the same results
+	require.Equal(t, []string{"1a", "2b"}, collect(t, zipped))
+}
+
+func TestZipReturnsErrorGivenResultSelectorError(t *testing.T) {
+	expectedErr := errors.New("test error")
+	zipped := Zip(
+		New([]int{1}),
+		New([]int{2}),
+		func(a int, b int) (int, error) {
+			return 0, expectedErr
+		},
+	)
+
+	_, err := zipped.Next()
+	require.ErrorIs(t, err, expectedErr)
+}
+
+func TestZipPairsYieldsPairs(t *testing.T) {
+	zipped := ZipPairs(New([]int{1, 2}), New([]string{"a", "b", "c"}))
+
+	require.Equal(
+		t,
+		[]immutable.Pair[int, string]{
+			immutable.NewPair(1, "a"),
+			immutable.NewPair(2, "b"),
+		},
+		collect(t, zipped),
+	)
+}
+
+func TestZipLongestYieldsNoneForExhaustedSide(t *testing.T) {
+	zipped := ZipLongestPairs(New([]int{1}), New([]string{"a", "b"}))
+
+	expected := []immutable.Pair[immutable.Option[int], immutable.Option[string]]{
+		immutable.NewPair(immutable.Some(1), immutable.Some("a")),
+		immutable.NewPair(immutable.None[int](), immutable.Some("b")),
+	}
+	require.Equal(t, expected, collect(t, zipped))
+	// Re-enumeration should yield the same results
+	require.Equal(t, expected, collect(t, zipped))
+}
+
+func TestZipLongestYieldsNoneForExhaustedFirstSide(t *testing.T) {
+	zipped := ZipLongest(
+		New([]int{1, 2}),
+		New([]int{10}),
+		func(a immutable.Option[int], b immutable.Option[int]) (int, error) {
+			return immutable.ValueOr(a, 0) + immutable.ValueOr(b, 0), nil
+		},
+	)
+
+	require.Equal(t, []int{11, 2}, collect(t, zipped))
+}
@@ -0,0 +1,43 @@
+package immutable
+
+// Enumerator represents a set of elements that can be iterated through multiple times.
+//
+// It has the same method set as `enumerable.Enumerable` and may be passed to any of the
+// operators in that package. It is declared here as the enumerable package depends on this
+// one.
+type Enumerator[T any] interface {
+	// Next attempts to evaluate the next item in the enumeration - allowing its
+	// exposure via the `Value()` function.
+	//
+	// It will return false if it has reached the end of the enumerable, and/or an
+	// error if one was generated during evaluation.
+	Next() (bool, error)
+
+	// Value returns the current item in the enumeration. It does not progress the
+	// enumeration, and should be a simple getter.
+	//
+	// If the previous Next call did not return true, or Next has never been called
+	// the behaviour and return value of this function is undefined.
+	Value() (T, error)
+
+	// Reset resets the enumerable, allowing for re-iteration.
+	Reset()
+}
+
+// keyEnumerator yields the keys of the key-value pairs yielded by its source.
+type keyEnumerator[K any, V any] struct {
+	source Enumerator[Pair[K, V]]
+}
+
+func (e *keyEnumerator[K, V]) Next() (bool, error) {
+	return e.source.Next()
+}
+
+func (e *keyEnumerator[K, V]) Value() (K, error) {
+	pair, err := e.source.Value()
+	return pair.First(), err
+}
+
+func (e *keyEnumerator[K, V]) Reset() {
+	e.source.Reset()
+}
@@ -0,0 +1,364 @@
+package immutable
+
+import "math/bits"
+
+// HashMap is a persistent, unordered mapping of keys to values.
+//
+// Each modification returns a new HashMap that shares the majority of its structure with
+// the original, which remains unchanged, allowing snapshots to be shared between goroutines
+// without copying. It is implemented as a hash array mapped trie.
+//
+// The zero value is an empty HashMap that uses the `DefaultHasher`.
+type HashMap[K any, V any] struct {
+	root   *hashMapNode[K, V]
+	size   int
+	hasher Hasher[K]
+}
+
+type hashMapNode[K any, V any] struct {
+	// A bit is set for each child index that holds an entry, entries are stored in the
+	// order of their bits.
+	//
+	// Unused by collision nodes.
+	bitmap uint32
+
+	entries []hashMapEntry[K, V]
+
+	// If true, this node holds entries for multiple keys that share the same hash.
+	collision bool
+}
+
+type hashMapEntry[K any, V any] struct {
+	// The child node of this entry, nil if this entry holds a key-value pair.
+	child *hashMapNode[K, V]
+
+	hash  uint32
+	key   K
+	value V
+}
+
+// NewHashMap returns an empty `HashMap` that uses the given hasher, or the `DefaultHasher`
+// if the given hasher is nil.
+func NewHashMap[K any, V any](hasher Hasher[K]) HashMap[K, V] {
+	return HashMap[K, V]{
+		hasher: hasher,
+	}
+}
+
+// Len returns the number of key-value pairs in this HashMap.
+func (m HashMap[K, V]) Len() int {
+	return m.size
+}
+
+// Get returns an `Option` containing the value for the given key. If the key is not
+// in this HashMap the returned `Option` will have no value.
+func (m HashMap[K, V]) Get(key K) Option[V] {
+	if m.root == nil {
+		return None[V]()
+	}
+	hasher := m.getHasher()
+	return m.root.get(hasher, hasher.Hash(key), key, 0)
+}
+
+// Set returns a new HashMap with the given key set to the given value.
+func (m HashMap[K, V]) Set(key K, value V) HashMap[K, V] {
+	hasher := m.getHasher()
+	entry := hashMapEntry[K, V]{
+		hash:  hasher.Hash(key),
+		key:   key,
+		value: value,
+	}
+
+	root := m.root
+	if root == nil {
+		root = &hashMapNode[K, V]{}
+	}
+
+	var added bool
+	m.root, added = root.set(hasher, entry, 0)
+	if added {
+		m.size++
+	}
+	return m
+}
+
+// Delete returns a new HashMap without the given key. If the key is not in this HashMap,
+// this HashMap is returned.
+func (m HashMap[K, V]) Delete(key K) HashMap[K, V] {
+	if m.root == nil {
+		return m
+	}
+
+	hasher := m.getHasher()
+	root, removed := m.root.delete(hasher, hasher.Hash(key), key, 0)
+	if !removed {
+		return m
+	}
+
+	m.size--
+	if m.size == 0 {
+		root = nil
+	}
+	m.root = root
+	return m
+}
+
+// Enumerable returns an `Enumerator` that yields the key-value pairs of this HashMap.
+//
+// The order in which the pairs are yielded is undefined, but will be consistent for a
+// given HashMap.
+func (m HashMap[K, V]) Enumerable() Enumerator[Pair[K, V]] {
+	return &hashMapEnumerator[K, V]{
+		root: m.root,
+	}
+}
+
+func (m HashMap[K, V]) getHasher() Hasher[K] {
+	if m.hasher == nil {
+		return defaultHasher[K]{}
+	}
+	return m.hasher
+}
+
+// hashMapIndex returns the child index of the given hash at the given depth.
+func hashMapIndex(hash uint32, shift uint) uint32 {
+	return (hash >> shift) & trieMask
+}
+
+// position returns the position within the entries slice of the given bit.
+func (n *hashMapNode[K, V]) position(bit uint32) int {
+	return bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+func (n *hashMapNode[K, V]) get(hasher Hasher[K], hash uint32, key K, shift uint) Option[V] {
+	for {
+		if n.collision {
+			for _, entry := range n.entries {
+				if hasher.Equal(entry.key, key) {
+					return Some(entry.value)
+				}
+			}
+			return None[V]()
+		}
+
+		bit := uint32(1) << hashMapIndex(hash, shift)
+		if n.bitmap&bit == 0 {
+			return None[V]()
+		}
+
+		entry := n.entries[n.position(bit)]
+		if entry.child == nil {
+			if entry.hash == hash && hasher.Equal(entry.key, key) {
+				return Some(entry.value)
+			}
+			return None[V]()
+		}
+
+		n = entry.child
+		shift += trieBits
+	}
+}
+
+// set returns a copy of this node with the given entry set, along with true if the
+// entry's key was not previously present.
+func (n *hashMapNode[K, V]) set(hasher Hasher[K], entry hashMapEntry[K, V], shift uint) (*hashMapNode[K, V], bool) {
+	if n.collision {
+		for i, existing := range n.entries {
+			if hasher.Equal(existing.key, entry.key) {
+				return n.withEntry(i, entry), false
+			}
+		}
+		return n.withInsertedEntry(len(n.entries), entry), true
+	}
+
+	bit := uint32(1) << hashMapIndex(entry.hash, shift)
+	position := n.position(bit)
+	if n.bitmap&bit == 0 {
+		result := n.withInsertedEntry(position, entry)
+		result.bitmap |= bit
+		return result, true
+	}
+
+	existing := n.entries[position]
+	if existing.child != nil {
+		child, added := existing.child.set(hasher, entry, shift+trieBits)
+		return n.withEntry(position, hashMapEntry[K, V]{child: child}), added
+	}
+
+	if existing.hash == entry.hash && hasher.Equal(existing.key, entry.key) {
+		return n.withEntry(position, entry), false
+	}
+
+	child := mergeHashMapEntries(existing, entry, shift+trieBits)
+	return n.withEntry(position, hashMapEntry[K, V]{child: child}), true
+}
+
+// delete returns a copy of this node without the given key, along with true if the key
+// was present. If the key was not present this node is returned.
+func (n *hashMapNode[K, V]) delete(hasher Hasher[K], hash uint32, key K, shift uint) (*hashMapNode[K, V], bool) {
+	if n.collision {
+		for i, existing := range n.entries {
+			if hasher.Equal(existing.key, key) {
+				return n.withoutEntry(i), true
+			}
+		}
+		return n, false
+	}
+
+	bit := uint32(1) << hashMapIndex(hash, shift)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+
+	position := n.position(bit)
+	existing := n.entries[position]
+	if existing.child == nil {
+		if existing.hash != hash || !hasher.Equal(existing.key, key) {
+			return n, false
+		}
+		result := n.withoutEntry(position)
+		result.bitmap &^= bit
+		return result, true
+	}
+
+	child, removed := existing.child.delete(hasher, hash, key, shift+trieBits)
+	if !removed {
+		return n, false
+	}
+
+	switch {
+	case len(child.entries) == 0:
+		result := n.withoutEntry(position)
+		result.bitmap &^= bit
+		return result, true
+
+	case len(child.entries) == 1 && child.entries[0].child == nil:
+		// Child nodes holding a single key-value pair are collapsed into their parent,
+		// so that each key is held at the shallowest possible depth.
+		return n.withEntry(position, child.entries[0]), true
+
+	default:
+		return n.withEntry(position, hashMapEntry[K, V]{child: child}), true
+	}
+}
+
+// withEntry returns a copy of this node with the entry at the given position replaced.
+func (n *hashMapNode[K, V]) withEntry(position int, entry hashMapEntry[K, V]) *hashMapNode[K, V] {
+	entries := make([]hashMapEntry[K, V], len(n.entries))
+	copy(entries, n.entries)
+	entries[position] = entry
+	return &hashMapNode[K, V]{
+		bitmap:    n.bitmap,
+		entries:   entries,
+		collision: n.collision,
+	}
+}
+
+// withInsertedEntry returns a copy of this node with the given entry inserted at the given
+// position.
+func (n *hashMapNode[K, V]) withInsertedEntry(position int, entry hashMapEntry[K, V]) *hashMapNode[K, V] {
+	entries := make([]hashMapEntry[K, V], len(n.entries)+1)
+	copy(entries, n.entries[:position])
+	entries[position] = entry
+	copy(entries[position+1:], n.entries[position:])
+	return &hashMapNode[K, V]{
+		bitmap:    n.bitmap,
+		entries:   entries,
+		collision: n.collision,
+	}
+}
+
+// withoutEntry returns a copy of this node without the entry at the given position.
+func (n *hashMapNode[K, V]) withoutEntry(position int) *hashMapNode[K, V] {
+	entries := make([]hashMapEntry[K, V], len(n.entries)-1)
+	copy(entries, n.entries[:position])
+	copy(entries[position:], n.entries[position+1:])
+	return &hashMapNode[K, V]{
+		bitmap:    n.bitmap,
+		entries:   entries,
+		collision: n.collision,
+	}
+}
+
+// mergeHashMapEntries returns a new node containing the two given key-value pair entries.
+func mergeHashMapEntries[K any, V any](a hashMapEntry[K, V], b hashMapEntry[K, V], shift uint) *hashMapNode[K, V] {
+	if a.hash == b.hash {
+		return &hashMapNode[K, V]{
+			entries:   []hashMapEntry[K, V]{a, b},
+			collision: true,
+		}
+	}
+
+	indexA := hashMapIndex(a.hash, shift)
+	indexB := hashMapIndex(b.hash, shift)
+	if indexA == indexB {
+		return &hashMapNode[K, V]{
+			bitmap: uint32(1) << indexA,
+			entries: []hashMapEntry[K, V]{
+				{child: mergeHashMapEntries(a, b, shift+trieBits)},
+			},
+		}
+	}
+
+	entries := []hashMapEntry[K, V]{a, b}
+	if indexB < indexA {
+		entries[0], entries[1] = b, a
+	}
+	return &hashMapNode[K, V]{
+		bitmap:  uint32(1)<<indexA | uint32(1)<<indexB,
+		entries: entries,
+	}
+}
+
+type hashMapEnumeratorFrame[K any, V any] struct {
+	node     *hashMapNode[K, V]
+	position int
+}
+
+type hashMapEnumerator[K any, V any] struct {
+	root *hashMapNode[K, V]
+
+	// The path from the root to the node holding the current entry.
+	stack []hashMapEnumeratorFrame[K, V]
+
+	started      bool
+	currentValue Pair[K, V]
+}
+
+func (e *hashMapEnumerator[K, V]) Next() (bool, error) {
+	if !e.started {
+		e.started = true
+		if e.root != nil {
+			e.stack = append(e.stack, hashMapEnumeratorFrame[K, V]{node: e.root, position: -1})
+		}
+	}
+
+	for len(e.stack) > 0 {
+		frame := &e.stack[len(e.stack)-1]
+		frame.position++
+		if frame.position >= len(frame.node.entries) {
+			e.stack = e.stack[:len(e.stack)-1]
+			continue
+		}
+
+		entry := frame.node.entries[frame.position]
+		if entry.child != nil {
+			e.stack = append(e.stack, hashMapEnumeratorFrame[K, V]{node: entry.child, position: -1})
+			continue
+		}
+
+		e.currentValue = NewPair(entry.key, entry.value)
+		return true, nil
+	}
+
+	return false, nil
+}
+
+func (e *hashMapEnumerator[K, V]) Value() (Pair[K, V], error) {
+	return e.currentValue, nil
+}
+
+func (e *hashMapEnumerator[K, V]) Reset() {
+	e.stack = e.stack[:0]
+	e.started = false
+}
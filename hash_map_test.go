@@ -0,0 +1,143 @@
+package immutable
+
+import (
+	"strconv"
+	"testing"
+)
+
+// collidingHasher hashes all strings into a small number of buckets, forcing collisions.
+type collidingHasher struct{}
+
+func (collidingHasher) Hash(key string) uint32 {
+	return uint32(len(key) % 3)
+}
+
+func (collidingHasher) Equal(a string, b string) bool {
+	return a == b
+}
+
+func hashMapValues[K comparable, V any](m HashMap[K, V]) map[K]V {
+	values := map[K]V{}
+	e := m.Enumerable()
+	for {
+		hasNext, _ := e.Next()
+		if !hasNext {
+			break
+		}
+		pair, _ := e.Value()
+		values[pair.First()] = pair.Second()
+	}
+	return values
+}
+
+func requireHashMapEquals[K comparable, V comparable](t *testing.T, expected map[K]V, m HashMap[K, V]) {
+	t.Helper()
+	if m.Len() != len(expected) {
+		t.Fatalf("expected length %v, got %v", len(expected), m.Len())
+	}
+	for k, v := range expected {
+		value := m.Get(k)
+		if !value.HasValue() || value.Value() != v {
+			t.Fatalf("expected %v for key %v, got %v", v, k, value)
+		}
+	}
+	values := hashMapValues(m)
+	if len(values) != len(expected) {
+		t.Fatalf("expected %v items to be enumerated, got %v", len(expected), len(values))
+	}
+	for k, v := range values {
+		if expected[k] != v {
+			t.Fatalf("expected %v for key %v during enumeration, got %v", expected[k], k, v)
+		}
+	}
+}
+
+func TestHashMapZeroValueIsEmpty(t *testing.T) {
+	var m HashMap[string, int]
+	if m.Get("a").HasValue() {
+		t.Errorf("expected Get to return an Option with no value")
+	}
+	requireHashMapEquals(t, map[string]int{}, m)
+}
+
+func TestHashMapSetAndDelete(t *testing.T) {
+	expected := map[int]int{}
+	m := HashMap[int, int]{}
+	for i := 0; i < 10_000; i++ {
+		m = m.Set(i, i*2)
+		expected[i] = i * 2
+	}
+	requireHashMapEquals(t, expected, m)
+
+	for i := 0; i < 10_000; i += 3 {
+		m = m.Delete(i)
+		delete(expected, i)
+	}
+	requireHashMapEquals(t, expected, m)
+}
+
+func TestHashMapSetReplacesExistingValue(t *testing.T) {
+	m := HashMap[string, int]{}.Set("a", 1).Set("a", 2)
+	requireHashMapEquals(t, map[string]int{"a": 2}, m)
+}
+
+func TestHashMapDeleteMissingKeyReturnsSameMap(t *testing.T) {
+	m := HashMap[string, int]{}.Set("a", 1)
+	result := m.Delete("b")
+	if result.root != m.root {
+		t.Errorf("expected Delete to return the same map")
+	}
+}
+
+func TestHashMapModificationsDoNotAffectPreviousVersions(t *testing.T) {
+	m1 := HashMap[string, int]{}.Set("a", 1).Set("b", 2)
+	m2 := m1.Set("a", 3)
+	m3 := m1.Delete("b")
+	m4 := m1.Set("c", 4)
+
+	requireHashMapEquals(t, map[string]int{"a": 1, "b": 2}, m1)
+	requireHashMapEquals(t, map[string]int{"a": 3, "b": 2}, m2)
+	requireHashMapEquals(t, map[string]int{"a": 1}, m3)
+	requireHashMapEquals(t, map[string]int{"a": 1, "b": 2, "c": 4}, m4)
+}
+
+func TestHashMapWithCollidingHasher(t *testing.T) {
+	expected := map[string]int{}
+	m := NewHashMap[string, int](collidingHasher{})
+	for i := 0; i < 100; i++ {
+		key := strconv.Itoa(i)
+		m = m.Set(key, i)
+		expected[key] = i
+	}
+	requireHashMapEquals(t, expected, m)
+
+	for i := 0; i < 100; i += 2 {
+		key := strconv.Itoa(i)
+		m = m.Delete(key)
+		delete(expected, key)
+	}
+	requireHashMapEquals(t, expected, m)
+
+	for k := range expected {
+		m = m.Delete(k)
+	}
+	requireHashMapEquals(t, map[string]int{}, m)
+}
+
+func TestHashMapWithStructKeys(t *testing.T) {
+	type key struct {
+		a int
+		b string
+	}
+	m := HashMap[key, int]{}.Set(key{1, "a"}, 1).Set(key{1, "b"}, 2)
+	requireHashMapEquals(t, map[key]int{{1, "a"}: 1, {1, "b"}: 2}, m)
+}
+
+func TestDefaultHasherHashesEqualFloatsEqually(t *testing.T) {
+	hasher := DefaultHasher[float64]()
+	negativeZero := 0.0
+	negativeZero = -negativeZero
+	if hasher.Hash(0.0) != hasher.Hash(negativeZero) {
+		t.Errorf("expected 0 and -0 to have the same hash")
+	}
+}
@@ -0,0 +1,142 @@
+package immutable
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+)
+
+// Hasher hashes and compares keys of type `K`.
+type Hasher[K any] interface {
+	// Hash returns the hash of the given key. Keys that are equal must have the same hash.
+	Hash(key K) uint32
+
+	// Equal returns true if the given keys are equal.
+	Equal(a K, b K) bool
+}
+
+// DefaultHasher returns a `Hasher` for built-in comparable types, and types composed
+// of them such as arrays, structs and pointers.
+//
+// Keys are compared using `==`, as such the returned Hasher will panic if given keys
+// that are not comparable.
+func DefaultHasher[K any]() Hasher[K] {
+	return defaultHasher[K]{}
+}
+
+type defaultHasher[K any] struct{}
+
+var _ Hasher[any] = defaultHasher[any]{}
+
+func (defaultHasher[K]) Hash(key K) uint32 {
+	switch k := any(key).(type) {
+	case string:
+		return hashString(k)
+	case int:
+		return hashUint64(uint64(k))
+	case int8:
+		return hashUint64(uint64(k))
+	case int16:
+		return hashUint64(uint64(k))
+	case int32:
+		return hashUint64(uint64(k))
+	case int64:
+		return hashUint64(uint64(k))
+	case uint:
+		return hashUint64(uint64(k))
+	case uint8:
+		return hashUint64(uint64(k))
+	case uint16:
+		return hashUint64(uint64(k))
+	case uint32:
+		return hashUint64(uint64(k))
+	case uint64:
+		return hashUint64(k)
+	case uintptr:
+		return hashUint64(uint64(k))
+	default:
+		return hashValue(reflect.ValueOf(key))
+	}
+}
+
+func (defaultHasher[K]) Equal(a K, b K) bool {
+	return any(a) == any(b)
+}
+
+// hashValue hashes the given value by walking its structure.
+func hashValue(v reflect.Value) uint32 {
+	switch v.Kind() {
+	case reflect.Invalid:
+		return 0
+	case reflect.Bool:
+		if v.Bool() {
+			return 1
+		}
+		return 0
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return hashUint64(uint64(v.Int()))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return hashUint64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return hashFloat(v.Float())
+	case reflect.Complex64, reflect.Complex128:
+		c := v.Complex()
+		return combineHashes(hashFloat(real(c)), hashFloat(imag(c)))
+	case reflect.String:
+		return hashString(v.String())
+	case reflect.Pointer, reflect.Chan, reflect.UnsafePointer:
+		return hashUint64(uint64(v.Pointer()))
+	case reflect.Interface:
+		return hashValue(v.Elem())
+	case reflect.Array:
+		var hash uint32
+		for i := 0; i < v.Len(); i++ {
+			hash = combineHashes(hash, hashValue(v.Index(i)))
+		}
+		return hash
+	case reflect.Struct:
+		var hash uint32
+		for i := 0; i < v.NumField(); i++ {
+			hash = combineHashes(hash, hashValue(v.Field(i)))
+		}
+		return hash
+	default:
+		panic(fmt.Sprintf("immutable: key of type %s is not hashable", v.Type()))
+	}
+}
+
+func hashFloat(f float64) uint32 {
+	if f == 0 {
+		// -0 and +0 are equal, and so must have the same hash.
+		return 0
+	}
+	return hashUint64(math.Float64bits(f))
+}
+
+// hashUint64 mixes the bits of the given value, using the finalizer from MurmurHash3.
+func hashUint64(x uint64) uint32 {
+	x ^= x >> 33
+	x *= 0xff51afd7ed558ccd
+	x ^= x >> 33
+	x *= 0xc4ceb9fe1a85ec53
+	x ^= x >> 33
+	return uint32(x)
+}
+
+// hashString hashes the given string using 32-bit FNV-1a.
+func hashString(s string) uint32 {
+	const (
+		offset32 = 2166136261
+		prime32  = 16777619
+	)
+	hash := uint32(offset32)
+	for i := 0; i < len(s); i++ {
+		hash ^= uint32(s[i])
+		hash *= prime32
+	}
+	return hash
+}
+
+func combineHashes(a uint32, b uint32) uint32 {
+	return (a ^ b) * 16777619
+}
@@ -0,0 +1,127 @@
+//go:build go1.23
+
+package immutable
+
+import "iter"
+
+// All returns an `iter.Seq2` that yields the indexes and values of this List in order.
+func (l List[T]) All() iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for value := range seqOf(l.Enumerable) {
+			if !yield(i, value) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Values returns an `iter.Seq` that yields the values of this List in order.
+func (l List[T]) Values() iter.Seq[T] {
+	return seqOf(l.Enumerable)
+}
+
+// All returns an `iter.Seq2` that yields the key-value pairs of this HashMap.
+func (m HashMap[K, V]) All() iter.Seq2[K, V] {
+	return seq2Of(m.Enumerable)
+}
+
+// Keys returns an `iter.Seq` that yields the keys of this HashMap.
+func (m HashMap[K, V]) Keys() iter.Seq[K] {
+	return keysOf(m.All())
+}
+
+// Values returns an `iter.Seq` that yields the values of this HashMap.
+func (m HashMap[K, V]) Values() iter.Seq[V] {
+	return valuesOf(m.All())
+}
+
+// All returns an `iter.Seq2` that yields the key-value pairs of this SortedMap in
+// ascending key order.
+func (m SortedMap[K, V]) All() iter.Seq2[K, V] {
+	return seq2Of(m.Enumerable)
+}
+
+// Backward returns an `iter.Seq2` that yields the key-value pairs of this SortedMap in
+// descending key order.
+func (m SortedMap[K, V]) Backward() iter.Seq2[K, V] {
+	return seq2Of(m.Reverse)
+}
+
+// Keys returns an `iter.Seq` that yields the keys of this SortedMap in ascending order.
+func (m SortedMap[K, V]) Keys() iter.Seq[K] {
+	return keysOf(m.All())
+}
+
+// Values returns an `iter.Seq` that yields the values of this SortedMap in ascending
+// key order.
+func (m SortedMap[K, V]) Values() iter.Seq[V] {
+	return valuesOf(m.All())
+}
+
+// All returns an `iter.Seq` that yields the values of this Set.
+func (s Set[T]) All() iter.Seq[T] {
+	return keysOf(s.values.All())
+}
+
+// All returns an `iter.Seq` that yields the values of this SortedSet in ascending order.
+func (s SortedSet[T]) All() iter.Seq[T] {
+	return keysOf(s.values.All())
+}
+
+// Backward returns an `iter.Seq` that yields the values of this SortedSet in descending
+// order.
+func (s SortedSet[T]) Backward() iter.Seq[T] {
+	return keysOf(s.values.Backward())
+}
+
+// seqOf returns an `iter.Seq` that yields the values of the `Enumerator`s returned by
+// the given function, which must not return errors.
+func seqOf[T any](enumerate func() Enumerator[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		e := enumerate()
+		for {
+			hasNext, _ := e.Next()
+			if !hasNext {
+				return
+			}
+			value, _ := e.Value()
+			if !yield(value) {
+				return
+			}
+		}
+	}
+}
+
+// seq2Of returns an `iter.Seq2` that yields the key-value pairs of the `Enumerator`s
+// returned by the given function, which must not return errors.
+func seq2Of[K any, V any](enumerate func() Enumerator[Pair[K, V]]) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for pair := range seqOf(enumerate) {
+			if !yield(pair.First(), pair.Second()) {
+				return
+			}
+		}
+	}
+}
+
+func keysOf[K any, V any](seq iter.Seq2[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range seq {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+func valuesOf[K any, V any](seq iter.Seq2[K, V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range seq {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,66 @@
+//go:build go1.23
+
+package immutable
+
+import (
+	"maps"
+	"slices"
+	"testing"
+)
+
+func TestListAll(t *testing.T) {
+	l := NewList(1, 2, 3)
+
+	indexes := []int{}
+	values := []int{}
+	for i, v := range l.All() {
+		indexes = append(indexes, i)
+		values = append(values, v)
+	}
+	requireIntsEqual(t, []int{0, 1, 2}, indexes)
+	requireIntsEqual(t, []int{1, 2, 3}, values)
+
+	// The sequence should be reusable
+	requireIntsEqual(t, []int{1, 2, 3}, slices.Collect(l.Values()))
+}
+
+func TestHashMapAll(t *testing.T) {
+	m := HashMap[int, int]{}.Set(1, 2).Set(3, 4)
+
+	values := maps.Collect(m.All())
+	if len(values) != 2 || values[1] != 2 || values[3] != 4 {
+		t.Errorf("expected map[1:2 3:4], got %v", values)
+	}
+	requireIntsEqual(t, []int{1, 3}, slices.Sorted(m.Keys()))
+	requireIntsEqual(t, []int{2, 4}, slices.Sorted(m.Values()))
+}
+
+func TestSortedMapAll(t *testing.T) {
+	m := SortedMap[int, int]{}.Set(3, 4).Set(1, 2)
+
+	requireIntsEqual(t, []int{1, 3}, slices.Collect(m.Keys()))
+	requireIntsEqual(t, []int{2, 4}, slices.Collect(m.Values()))
+
+	keys := []int{}
+	for k := range m.Backward() {
+		keys = append(keys, k)
+	}
+	requireIntsEqual(t, []int{3, 1}, keys)
+}
+
+func TestSetAll(t *testing.T) {
+	requireIntsEqual(t, []int{1, 2}, slices.Sorted(NewSet(2, 1).All()))
+	requireIntsEqual(t, []int{1, 2}, slices.Collect(NewSortedSet[int](nil, 2, 1).All()))
+	requireIntsEqual(t, []int{2, 1}, slices.Collect(NewSortedSet[int](nil, 2, 1).Backward()))
+}
+
+func TestSeqStopsGivenBreak(t *testing.T) {
+	values := []int{}
+	for v := range NewList(1, 2, 3).Values() {
+		if v == 2 {
+			break
+		}
+		values = append(values, v)
+	}
+	requireIntsEqual(t, []int{1}, values)
+}
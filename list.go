@@ -0,0 +1,315 @@
+package immutable
+
+import "fmt"
+
+const (
+	// The number of bits of an index consumed by each level of a trie.
+	trieBits = 5
+	// The number of children/values held by each node of a trie.
+	trieWidth = 1 << trieBits
+	trieMask  = trieWidth - 1
+)
+
+// editToken marks the nodes owned by a builder, which may be mutated in place.
+//
+// It must not be zero-sized, as pointers to distinct zero-sized values may compare as equal.
+type editToken struct {
+	_ byte
+}
+
+// List is a persistent, ordered, indexed sequence of values.
+//
+// Each modification returns a new List that shares the majority of its structure with
+// the original, which remains unchanged. It is implemented as a 32-way trie, providing
+// O(log32 n) `Get`, `Set`, `Append`, `Prepend` and `Slice`.
+//
+// The zero value is an empty List.
+type List[T any] struct {
+	root *listNode[T]
+
+	// The number of bits to shift an index by to get the child index at the root.
+	shift uint
+
+	// The index within the trie of the first value in the list. Values are stored
+	// at indexes origin to origin+size-1.
+	origin int
+
+	// The number of values in the list.
+	size int
+}
+
+type listNode[T any] struct {
+	// The builder that owns this node, nil if it is owned by no builder.
+	edit *editToken
+
+	// The child nodes of this node, nil if this is a leaf node.
+	children []*listNode[T]
+
+	// The values held by this node, nil if this is a branch node.
+	values []T
+}
+
+// NewList returns a `List` containing the given values.
+func NewList[T any](values ...T) List[T] {
+	builder := NewListBuilder[T]()
+	for _, value := range values {
+		builder.Append(value)
+	}
+	return builder.List()
+}
+
+// Len returns the number of values in this List.
+func (l List[T]) Len() int {
+	return l.size
+}
+
+// Get returns the value at the given index. It will panic if the index is out of range.
+func (l List[T]) Get(index int) T {
+	l.checkIndex(index)
+	trieIndex := l.origin + index
+	return l.leafFor(trieIndex)[trieIndex&trieMask]
+}
+
+// Set returns a new List with the value at the given index replaced with the given value.
+// It will panic if the index is out of range.
+func (l List[T]) Set(index int, value T) List[T] {
+	return l.set(nil, index, value)
+}
+
+// Append returns a new List with the given value added to the end.
+func (l List[T]) Append(value T) List[T] {
+	return l.append(nil, value)
+}
+
+// Prepend returns a new List with the given value added to the start.
+func (l List[T]) Prepend(value T) List[T] {
+	return l.prepend(nil, value)
+}
+
+// Slice returns a new List containing the values from the start index up to, but not
+// including, the end index. It will panic if the indexes are out of range.
+//
+// The returned List shares structure with this List.
+func (l List[T]) Slice(start int, end int) List[T] {
+	if start < 0 || end < start || end > l.size {
+		panic(fmt.Sprintf("immutable: slice bounds [%d:%d] out of range with length %d", start, end, l.size))
+	}
+	if start == end {
+		return List[T]{}
+	}
+
+	result := List[T]{
+		root:   l.root,
+		shift:  l.shift,
+		origin: l.origin + start,
+		size:   end - start,
+	}
+
+	// Drop any levels of the trie that are not needed to hold the remaining values.
+	for result.shift > 0 {
+		first := (result.origin >> result.shift) & trieMask
+		last := ((result.origin + result.size - 1) >> result.shift) & trieMask
+		if first != last {
+			break
+		}
+		result.root = result.root.children[first]
+		result.origin -= first << result.shift
+		result.shift -= trieBits
+	}
+
+	return result
+}
+
+// Enumerable returns an `Enumerator` that yields the values of this List in order.
+//
+// The List is not modified by enumeration.
+func (l List[T]) Enumerable() Enumerator[T] {
+	return &listEnumerator[T]{
+		list:  l,
+		index: -1,
+	}
+}
+
+func (l List[T]) checkIndex(index int) {
+	if index < 0 || index >= l.size {
+		panic(fmt.Sprintf("immutable: index %d out of range with length %d", index, l.size))
+	}
+}
+
+// capacity returns the number of values the trie can hold without growing.
+func (l List[T]) capacity() int {
+	return 1 << (l.shift + trieBits)
+}
+
+// leafFor returns the values of the leaf node holding the given trie index.
+func (l List[T]) leafFor(trieIndex int) []T {
+	node := l.root
+	for shift := l.shift; shift > 0; shift -= trieBits {
+		node = node.children[(trieIndex>>shift)&trieMask]
+	}
+	return node.values
+}
+
+func (l List[T]) set(edit *editToken, index int, value T) List[T] {
+	l.checkIndex(index)
+	l.root = l.root.setValue(edit, l.shift, l.origin+index, value)
+	return l
+}
+
+func (l List[T]) append(edit *editToken, value T) List[T] {
+	trieIndex := l.origin + l.size
+	if trieIndex >= l.capacity() {
+		// The trie is full to the right, so a new root is added with the old root as
+		// its first child.
+		root := (*listNode[T])(nil).editable(edit, false)
+		root.children[0] = l.root
+		l.root = root
+		l.shift += trieBits
+	}
+
+	l.root = l.root.setValue(edit, l.shift, trieIndex, value)
+	l.size++
+	return l
+}
+
+func (l List[T]) prepend(edit *editToken, value T) List[T] {
+	if l.size == 0 {
+		return l.append(edit, value)
+	}
+
+	if l.origin == 0 {
+		// The trie is full to the left, so a new root is added with the old root as its
+		// middle child, leaving space for further values to be added at either end.
+		middle := trieWidth / 2
+		root := (*listNode[T])(nil).editable(edit, false)
+		root.children[middle] = l.root
+		l.origin += middle << (l.shift + trieBits)
+		l.root = root
+		l.shift += trieBits
+	}
+
+	l.origin--
+	l.root = l.root.setValue(edit, l.shift, l.origin, value)
+	l.size++
+	return l
+}
+
+// editable returns a version of this node that may be mutated by the owner of the given
+// edit token, copying it if required. This node may be nil, in which case a new node is
+// returned.
+func (n *listNode[T]) editable(edit *editToken, leaf bool) *listNode[T] {
+	if n != nil && edit != nil && n.edit == edit {
+		return n
+	}
+
+	result := &listNode[T]{
+		edit: edit,
+	}
+	if leaf {
+		result.values = make([]T, trieWidth)
+		if n != nil {
+			copy(result.values, n.values)
+		}
+	} else {
+		result.children = make([]*listNode[T], trieWidth)
+		if n != nil {
+			copy(result.children, n.children)
+		}
+	}
+	return result
+}
+
+// setValue returns a version of this node with the value at the given trie index replaced,
+// copying every node on the path to the value that is not owned by the given edit token.
+func (n *listNode[T]) setValue(edit *editToken, shift uint, trieIndex int, value T) *listNode[T] {
+	if shift == 0 {
+		result := n.editable(edit, true)
+		result.values[trieIndex&trieMask] = value
+		return result
+	}
+
+	result := n.editable(edit, false)
+	childIndex := (trieIndex >> shift) & trieMask
+	result.children[childIndex] = result.children[childIndex].setValue(edit, shift-trieBits, trieIndex, value)
+	return result
+}
+
+// ListBuilder allows a `List` to be constructed efficiently by mutating it in place.
+//
+// A ListBuilder must not be used concurrently.
+type ListBuilder[T any] struct {
+	list List[T]
+	edit *editToken
+}
+
+// NewListBuilder returns a new `ListBuilder` containing an empty list.
+func NewListBuilder[T any]() *ListBuilder[T] {
+	return &ListBuilder[T]{
+		edit: &editToken{},
+	}
+}
+
+// Len returns the number of values in the list being built.
+func (b *ListBuilder[T]) Len() int {
+	return b.list.Len()
+}
+
+// Get returns the value at the given index. It will panic if the index is out of range.
+func (b *ListBuilder[T]) Get(index int) T {
+	return b.list.Get(index)
+}
+
+// Set replaces the value at the given index. It will panic if the index is out of range.
+func (b *ListBuilder[T]) Set(index int, value T) {
+	b.list = b.list.set(b.edit, index, value)
+}
+
+// Append adds the given value to the end of the list.
+func (b *ListBuilder[T]) Append(value T) {
+	b.list = b.list.append(b.edit, value)
+}
+
+// Prepend adds the given value to the start of the list.
+func (b *ListBuilder[T]) Prepend(value T) {
+	b.list = b.list.prepend(b.edit, value)
+}
+
+// List returns the built `List`.
+//
+// The builder may continue to be used afterwards without affecting the returned List.
+func (b *ListBuilder[T]) List() List[T] {
+	// Replacing the edit token prevents the builder from mutating any of the nodes
+	// shared with the returned list.
+	b.edit = &editToken{}
+	return b.list
+}
+
+type listEnumerator[T any] struct {
+	list  List[T]
+	index int
+
+	// The values of the leaf node containing the current index.
+	leaf []T
+}
+
+func (e *listEnumerator[T]) Next() (bool, error) {
+	if e.index+1 >= e.list.size {
+		return false, nil
+	}
+	e.index++
+
+	trieIndex := e.list.origin + e.index
+	if e.leaf == nil || trieIndex&trieMask == 0 {
+		e.leaf = e.list.leafFor(trieIndex)
+	}
+	return true, nil
+}
+
+func (e *listEnumerator[T]) Value() (T, error) {
+	return e.leaf[(e.list.origin+e.index)&trieMask], nil
+}
+
+func (e *listEnumerator[T]) Reset() {
+	e.index = -1
+	e.leaf = nil
+}
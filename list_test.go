@@ -0,0 +1,169 @@
+package immutable
+
+import "testing"
+
+func listValues[T any](l List[T]) []T {
+	values := make([]T, 0, l.Len())
+	e := l.Enumerable()
+	for {
+		hasNext, _ := e.Next()
+		if !hasNext {
+			break
+		}
+		value, _ := e.Value()
+		values = append(values, value)
+	}
+	return values
+}
+
+func requireListEquals(t *testing.T, expected []int, l List[int]) {
+	t.Helper()
+	if l.Len() != len(expected) {
+		t.Fatalf("expected length %v, got %v", len(expected), l.Len())
+	}
+	for i, v := range expected {
+		if l.Get(i) != v {
+			t.Fatalf("expected %v at index %v, got %v", v, i, l.Get(i))
+		}
+	}
+	for i, v := range listValues(l) {
+		if expected[i] != v {
+			t.Fatalf("expected %v at index %v during enumeration, got %v", expected[i], i, v)
+		}
+	}
+}
+
+func TestListZeroValueIsEmpty(t *testing.T) {
+	var l List[int]
+	requireListEquals(t, []int{}, l)
+}
+
+func TestListAppend(t *testing.T) {
+	expected := []int{}
+	l := List[int]{}
+	for i := 0; i < 100_000; i++ {
+		l = l.Append(i)
+		expected = append(expected, i)
+	}
+	requireListEquals(t, expected, l)
+}
+
+func TestListPrepend(t *testing.T) {
+	expected := make([]int, 100_000)
+	l := List[int]{}
+	for i := 0; i < 100_000; i++ {
+		l = l.Prepend(i)
+		expected[len(expected)-1-i] = i
+	}
+	requireListEquals(t, expected, l)
+}
+
+func TestListAppendAndPrepend(t *testing.T) {
+	expected := []int{}
+	l := List[int]{}
+	for i := 0; i < 10_000; i++ {
+		if i%3 == 0 {
+			l = l.Prepend(i)
+			expected = append([]int{i}, expected...)
+		} else {
+			l = l.Append(i)
+			expected = append(expected, i)
+		}
+	}
+	requireListEquals(t, expected, l)
+}
+
+func TestListModificationsDoNotAffectPreviousVersions(t *testing.T) {
+	l1 := NewList(1, 2, 3)
+	l2 := l1.Set(1, 5)
+	l3 := l1.Append(4)
+	l4 := l1.Prepend(0)
+
+	requireListEquals(t, []int{1, 2, 3}, l1)
+	requireListEquals(t, []int{1, 5, 3}, l2)
+	requireListEquals(t, []int{1, 2, 3, 4}, l3)
+	requireListEquals(t, []int{0, 1, 2, 3}, l4)
+}
+
+func TestListSlice(t *testing.T) {
+	expected := []int{}
+	for i := 0; i < 5_000; i++ {
+		expected = append(expected, i)
+	}
+	l := NewList(expected...)
+
+	requireListEquals(t, expected[100:4000], l.Slice(100, 4000))
+	requireListEquals(t, expected[40:50], l.Slice(40, 50))
+	requireListEquals(t, []int{}, l.Slice(10, 10))
+	requireListEquals(t, expected, l)
+}
+
+func TestListSliceCanBeModified(t *testing.T) {
+	l := NewList(0, 1, 2, 3, 4, 5).Slice(2, 4)
+	l = l.Prepend(-1).Append(10).Set(1, 20)
+
+	requireListEquals(t, []int{-1, 20, 3, 10}, l)
+}
+
+func TestListGetPanicsGivenOutOfRangeIndex(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("expected Get to panic")
+		}
+	}()
+	NewList(1).Get(1)
+}
+
+func TestListBuilder(t *testing.T) {
+	builder := NewListBuilder[int]()
+	for i := 1; i <= 1_000; i++ {
+		builder.Append(i)
+	}
+	builder.Prepend(0)
+	builder.Set(1, 100)
+
+	l := builder.List()
+
+	expected := []int{0, 100}
+	for i := 2; i <= 1_000; i++ {
+		expected = append(expected, i)
+	}
+	requireListEquals(t, expected, l)
+}
+
+func TestListBuilderDoesNotAffectBuiltList(t *testing.T) {
+	builder := NewListBuilder[int]()
+	builder.Append(1)
+	builder.Append(2)
+
+	l := builder.List()
+
+	builder.Set(0, 3)
+	builder.Append(4)
+
+	requireListEquals(t, []int{1, 2}, l)
+	requireListEquals(t, []int{3, 2, 4}, builder.List())
+}
+
+func TestListEnumerableResets(t *testing.T) {
+	e := NewList(1, 2).Enumerable()
+
+	hasNext, _ := e.Next()
+	if !hasNext {
+		t.Errorf("expected an item")
+	}
+	e.Reset()
+
+	values := []int{}
+	for {
+		hasNext, _ := e.Next()
+		if !hasNext {
+			break
+		}
+		value, _ := e.Value()
+		values = append(values, value)
+	}
+	if len(values) != 2 || values[0] != 1 || values[1] != 2 {
+		t.Errorf("expected [1 2], got %v", values)
+	}
+}
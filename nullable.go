@@ -0,0 +1,120 @@
+package immutable
+
+import "encoding/json"
+
+type nullableState uint8
+
+const (
+	undefinedState nullableState = iota
+	nullState
+	valueState
+)
+
+// Nullable represents an item that may be undefined, explicitly null, or contain a value.
+//
+// Unlike `Option`, it distinguishes between a value that was never provided and one that
+// was explicitly set to null, which is useful for partial updates. The zero value is
+// undefined.
+type Nullable[T any] struct {
+	state nullableState
+
+	// The Value of this Nullable. Should be ignored if HasValue is false.
+	value T
+}
+
+// Undefined returns a `Nullable` of type `T` that has not been defined.
+func Undefined[T any]() Nullable[T] {
+	return Nullable[T]{}
+}
+
+// Null returns a `Nullable` of type `T` that has been explicitly set to null.
+func Null[T any]() Nullable[T] {
+	return Nullable[T]{
+		state: nullState,
+	}
+}
+
+// NonNull returns a `Nullable` of type `T` with the given value.
+func NonNull[T any](value T) Nullable[T] {
+	return Nullable[T]{
+		state: valueState,
+		value: value,
+	}
+}
+
+// NullableFromOption returns a `Nullable` with the value of the given `Option`. If the
+// `Option` has no value, the returned `Nullable` will be null.
+func NullableFromOption[T any](o Option[T]) Nullable[T] {
+	if o.HasValue() {
+		return NonNull(o.Value())
+	}
+	return Null[T]()
+}
+
+// IsUndefined returns true if this Nullable has not been defined.
+func (n Nullable[T]) IsUndefined() bool {
+	return n.state == undefinedState
+}
+
+// IsNull returns true if this Nullable has been explicitly set to null.
+func (n Nullable[T]) IsNull() bool {
+	return n.state == nullState
+}
+
+// HasValue returns true if this Nullable contains a value.
+func (n Nullable[T]) HasValue() bool {
+	return n.state == valueState
+}
+
+// Value returns the Value of this Nullable. Value returned is invalid HasValue() is false
+// and should be ignored.
+func (n Nullable[T]) Value() T {
+	return n.value
+}
+
+// Option returns an `Option` containing the value of this Nullable. If this Nullable
+// is undefined or null the returned `Option` will have no value.
+func (n Nullable[T]) Option() Option[T] {
+	if n.HasValue() {
+		return Some(n.value)
+	}
+	return None[T]()
+}
+
+// IsZero returns true if this Nullable is undefined.
+//
+// This allows undefined fields to be omitted from JSON using the `omitzero` struct tag
+// option, available from Go 1.24. Older toolchains ignore the option, so undefined fields
+// cannot be omitted and are marshalled as null.
+func (n Nullable[T]) IsZero() bool {
+	return n.IsUndefined()
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+//
+// Undefined values are marshalled as null, use the `omitzero` struct tag option to omit
+// them instead. This requires Go 1.24, on older toolchains undefined fields cannot be
+// omitted.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if n.HasValue() {
+		return json.Marshal(n.value)
+	}
+	return []byte("null"), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+//
+// Fields missing from the JSON will not be unmarshalled and will remain undefined.
+func (n *Nullable[T]) UnmarshalJSON(b []byte) error {
+	if string(b) == "null" {
+		*n = Null[T]()
+		return nil
+	}
+	var value T
+	err := json.Unmarshal(b, &value)
+	if err != nil {
+		return err
+	}
+	*n = NonNull(value)
+	return nil
+}
@@ -0,0 +1,26 @@
+//go:build go1.24
+
+package immutable
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type nullableTestOmitPatch struct {
+	Name Nullable[string] `json:"name,omitzero"`
+	Age  Nullable[int]    `json:"age,omitzero"`
+}
+
+func TestNullableMarshalOmitsUndefined(t *testing.T) {
+	patch := nullableTestOmitPatch{
+		Name: Null[string](),
+	}
+	b, err := json.Marshal(patch)
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if string(b) != `{"name":null}` {
+		t.Errorf(`expected {"name":null}, got %s`, b)
+	}
+}
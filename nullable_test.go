@@ -0,0 +1,91 @@
+package immutable
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type nullableTestPatch struct {
+	Name Nullable[string] `json:"name"`
+	Age  Nullable[int]    `json:"age"`
+}
+
+func TestUndefined(t *testing.T) {
+	n := Undefined[int]()
+	if !n.IsUndefined() || n.IsNull() || n.HasValue() {
+		t.Errorf("expected Undefined to return an undefined Nullable")
+	}
+	if n != (Nullable[int]{}) {
+		t.Errorf("expected Undefined to return the zero value")
+	}
+}
+
+func TestNull(t *testing.T) {
+	n := Null[int]()
+	if n.IsUndefined() || !n.IsNull() || n.HasValue() {
+		t.Errorf("expected Null to return a null Nullable")
+	}
+}
+
+func TestNonNull(t *testing.T) {
+	n := NonNull(1)
+	if n.IsUndefined() || n.IsNull() || !n.HasValue() {
+		t.Errorf("expected NonNull to return a Nullable with a value")
+	}
+	if n.Value() != 1 {
+		t.Errorf("expected 1, got %v", n.Value())
+	}
+}
+
+func TestNullableOptionConversion(t *testing.T) {
+	if opt := NonNull(1).Option(); !opt.HasValue() || opt.Value() != 1 {
+		t.Errorf("expected Some(1), got %v", opt)
+	}
+	if opt := Null[int]().Option(); opt.HasValue() {
+		t.Errorf("expected None, got %v", opt)
+	}
+	if opt := Undefined[int]().Option(); opt.HasValue() {
+		t.Errorf("expected None, got %v", opt)
+	}
+	if n := NullableFromOption(Some(1)); !n.HasValue() || n.Value() != 1 {
+		t.Errorf("expected NonNull(1), got %v", n)
+	}
+	if n := NullableFromOption(None[int]()); !n.IsNull() {
+		t.Errorf("expected Null, got %v", n)
+	}
+}
+
+func TestNullableUnmarshalDistinguishesMissingFromNull(t *testing.T) {
+	var patch nullableTestPatch
+	err := json.Unmarshal([]byte(`{"name":null}`), &patch)
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if !patch.Name.IsNull() {
+		t.Errorf("expected name to be null")
+	}
+	if !patch.Age.IsUndefined() {
+		t.Errorf("expected age to be undefined")
+	}
+}
+
+func TestNullableUnmarshalValue(t *testing.T) {
+	var patch nullableTestPatch
+	err := json.Unmarshal([]byte(`{"age":1}`), &patch)
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if !patch.Age.HasValue() || patch.Age.Value() != 1 {
+		t.Errorf("expected age to be 1")
+	}
+}
+
+func TestNullableMarshalValue(t *testing.T) {
+	b, err := json.Marshal(NonNull(1))
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if string(b) != "1" {
+		t.Errorf("expected 1, got %s", b)
+	}
+}
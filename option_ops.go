@@ -0,0 +1,140 @@
+package immutable
+
+// Map returns an `Option` containing the result of the given function applied
+// to the value of the given `Option`. If the given `Option` has no value the
+// function is not called and an `Option` with no value is returned.
+func Map[T any, U any](o Option[T], f func(T) U) Option[U] {
+	if !o.HasValue() {
+		return None[U]()
+	}
+	return Some(f(o.Value()))
+}
+
+// TryMap behaves like `Map`, but allows the given function to return an error.
+//
+// If an error is returned by the function it will be returned alongside an `Option`
+// with no value.
+func TryMap[T any, U any](o Option[T], f func(T) (U, error)) (Option[U], error) {
+	if !o.HasValue() {
+		return None[U](), nil
+	}
+	value, err := f(o.Value())
+	if err != nil {
+		return None[U](), err
+	}
+	return Some(value), nil
+}
+
+// FlatMap returns the `Option` returned by the given function when applied to
+// the value of the given `Option`. If the given `Option` has no value the
+// function is not called and an `Option` with no value is returned.
+func FlatMap[T any, U any](o Option[T], f func(T) Option[U]) Option[U] {
+	if !o.HasValue() {
+		return None[U]()
+	}
+	return f(o.Value())
+}
+
+// TryFlatMap behaves like `FlatMap`, but allows the given function to return an error.
+//
+// If an error is returned by the function it will be returned alongside an `Option`
+// with no value.
+func TryFlatMap[T any, U any](o Option[T], f func(T) (Option[U], error)) (Option[U], error) {
+	if !o.HasValue() {
+		return None[U](), nil
+	}
+	result, err := f(o.Value())
+	if err != nil {
+		return None[U](), err
+	}
+	return result, nil
+}
+
+// Filter returns the given `Option` if it has a value and that value passes the
+// given predicate, otherwise an `Option` with no value is returned.
+func Filter[T any](o Option[T], predicate func(T) bool) Option[T] {
+	if !o.HasValue() || !predicate(o.Value()) {
+		return None[T]()
+	}
+	return o
+}
+
+// TryFilter behaves like `Filter`, but allows the given predicate to return an error.
+//
+// If an error is returned by the predicate it will be returned alongside an `Option`
+// with no value.
+func TryFilter[T any](o Option[T], predicate func(T) (bool, error)) (Option[T], error) {
+	if !o.HasValue() {
+		return o, nil
+	}
+	passes, err := predicate(o.Value())
+	if err != nil || !passes {
+		return None[T](), err
+	}
+	return o, nil
+}
+
+// OrElse returns the given `Option` if it has a value, otherwise the given
+// alternative is returned.
+func OrElse[T any](o Option[T], alternative Option[T]) Option[T] {
+	if o.HasValue() {
+		return o
+	}
+	return alternative
+}
+
+// OrElseGet returns the given `Option` if it has a value, otherwise the result of
+// the given function is returned.
+//
+// The function is only called if the given `Option` has no value.
+func OrElseGet[T any](o Option[T], f func() Option[T]) Option[T] {
+	if o.HasValue() {
+		return o
+	}
+	return f()
+}
+
+// TryOrElseGet behaves like `OrElseGet`, but allows the given function to return an error.
+func TryOrElseGet[T any](o Option[T], f func() (Option[T], error)) (Option[T], error) {
+	if o.HasValue() {
+		return o, nil
+	}
+	return f()
+}
+
+// ValueOr returns the value of the given `Option` if it has one, otherwise the
+// given default value is returned.
+func ValueOr[T any](o Option[T], defaultValue T) T {
+	if o.HasValue() {
+		return o.Value()
+	}
+	return defaultValue
+}
+
+// Zip returns an `Option` containing a `Pair` of the values of the two given
+// `Option`s. If either of the given `Option`s has no value an `Option` with no
+// value is returned.
+func Zip[A any, B any](a Option[A], b Option[B]) Option[Pair[A, B]] {
+	if !a.HasValue() || !b.HasValue() {
+		return None[Pair[A, B]]()
+	}
+	return Some(NewPair(a.Value(), b.Value()))
+}
+
+// Unzip splits the given `Option` of `Pair` into two `Option`s. If the given
+// `Option` has no value, neither of the returned `Option`s will have a value.
+func Unzip[A any, B any](o Option[Pair[A, B]]) (Option[A], Option[B]) {
+	if !o.HasValue() {
+		return None[A](), None[B]()
+	}
+	pair := o.Value()
+	return Some(pair.First()), Some(pair.Second())
+}
+
+// Flatten removes one level of nesting from the given `Option`.
+func Flatten[T any](o Option[Option[T]]) Option[T] {
+	if !o.HasValue() {
+		return None[T]()
+	}
+	return o.Value()
+}
@@ -0,0 +1,196 @@
+package immutable
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestMap(t *testing.T) {
+	opt := Map(Some(1), strconv.Itoa)
+	if !opt.HasValue() {
+		t.Errorf("expected Map to return an Option with a value")
+	}
+	if opt.Value() != "1" {
+		t.Errorf("expected 1, got %s", opt.Value())
+	}
+}
+
+func TestMapNone(t *testing.T) {
+	called := false
+	opt := Map(None[int](), func(v int) string {
+		called = true
+		return strconv.Itoa(v)
+	})
+	if opt.HasValue() {
+		t.Errorf("expected Map to return an Option with no value")
+	}
+	if called {
+		t.Errorf("expected Map not to call the function given None")
+	}
+}
+
+func TestTryMap(t *testing.T) {
+	opt, err := TryMap(Some("1"), strconv.Atoi)
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if !opt.HasValue() || opt.Value() != 1 {
+		t.Errorf("expected TryMap to return an Option with a value of 1")
+	}
+}
+
+func TestTryMapError(t *testing.T) {
+	opt, err := TryMap(Some("one"), strconv.Atoi)
+	if err == nil {
+		t.Errorf("expected an error")
+	}
+	if opt.HasValue() {
+		t.Errorf("expected TryMap to return an Option with no value")
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	f := func(v int) Option[int] {
+		if v > 1 {
+			return Some(v * 2)
+		}
+		return None[int]()
+	}
+
+	opt := FlatMap(Some(2), f)
+	if !opt.HasValue() || opt.Value() != 4 {
+		t.Errorf("expected FlatMap to return an Option with a value of 4")
+	}
+
+	opt = FlatMap(Some(1), f)
+	if opt.HasValue() {
+		t.Errorf("expected FlatMap to return an Option with no value")
+	}
+
+	opt = FlatMap(None[int](), f)
+	if opt.HasValue() {
+		t.Errorf("expected FlatMap to return an Option with no value")
+	}
+}
+
+func TestTryFlatMapError(t *testing.T) {
+	expectedErr := errors.New("test error")
+	opt, err := TryFlatMap(Some(1), func(v int) (Option[int], error) {
+		return Some(v), expectedErr
+	})
+	if !errors.Is(err, expectedErr) {
+		t.Errorf("expected %v, got %v", expectedErr, err)
+	}
+	if opt.HasValue() {
+		t.Errorf("expected TryFlatMap to return an Option with no value")
+	}
+}
+
+func TestFilter(t *testing.T) {
+	isEven := func(v int) bool { return v%2 == 0 }
+
+	opt := Filter(Some(2), isEven)
+	if !opt.HasValue() || opt.Value() != 2 {
+		t.Errorf("expected Filter to return an Option with a value of 2")
+	}
+
+	opt = Filter(Some(1), isEven)
+	if opt.HasValue() {
+		t.Errorf("expected Filter to return an Option with no value")
+	}
+}
+
+func TestTryFilterError(t *testing.T) {
+	expectedErr := errors.New("test error")
+	opt, err := TryFilter(Some(1), func(v int) (bool, error) {
+		return true, expectedErr
+	})
+	if !errors.Is(err, expectedErr) {
+		t.Errorf("expected %v, got %v", expectedErr, err)
+	}
+	if opt.HasValue() {
+		t.Errorf("expected TryFilter to return an Option with no value")
+	}
+}
+
+func TestOrElse(t *testing.T) {
+	opt := OrElse(Some(1), Some(2))
+	if opt.Value() != 1 {
+		t.Errorf("expected 1, got %v", opt.Value())
+	}
+
+	opt = OrElse(None[int](), Some(2))
+	if opt.Value() != 2 {
+		t.Errorf("expected 2, got %v", opt.Value())
+	}
+}
+
+func TestOrElseGet(t *testing.T) {
+	called := false
+	f := func() Option[int] {
+		called = true
+		return Some(2)
+	}
+
+	opt := OrElseGet(Some(1), f)
+	if opt.Value() != 1 {
+		t.Errorf("expected 1, got %v", opt.Value())
+	}
+	if called {
+		t.Errorf("expected OrElseGet not to call the function given Some")
+	}
+
+	opt = OrElseGet(None[int](), f)
+	if opt.Value() != 2 {
+		t.Errorf("expected 2, got %v", opt.Value())
+	}
+}
+
+func TestValueOr(t *testing.T) {
+	if v := ValueOr(Some(1), 2); v != 1 {
+		t.Errorf("expected 1, got %v", v)
+	}
+	if v := ValueOr(None[int](), 2); v != 2 {
+		t.Errorf("expected 2, got %v", v)
+	}
+}
+
+func TestZipAndUnzip(t *testing.T) {
+	opt := Zip(Some(1), Some("a"))
+	if !opt.HasValue() {
+		t.Errorf("expected Zip to return an Option with a value")
+	}
+
+	a, b := Unzip(opt)
+	if a.Value() != 1 || b.Value() != "a" {
+		t.Errorf("expected 1 and a, got %v and %v", a.Value(), b.Value())
+	}
+
+	opt = Zip(Some(1), None[string]())
+	if opt.HasValue() {
+		t.Errorf("expected Zip to return an Option with no value")
+	}
+
+	a, b = Unzip(opt)
+	if a.HasValue() || b.HasValue() {
+		t.Errorf("expected Unzip to return Options with no value")
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	opt := Flatten(Some(Some(1)))
+	if !opt.HasValue() || opt.Value() != 1 {
+		t.Errorf("expected Flatten to return an Option with a value of 1")
+	}
+
+	opt = Flatten(Some(None[int]()))
+	if opt.HasValue() {
+		t.Errorf("expected Flatten to return an Option with no value")
+	}
+
+	opt = Flatten(None[Option[int]]())
+	if opt.HasValue() {
+		t.Errorf("expected Flatten to return an Option with no value")
+	}
+}
@@ -0,0 +1,157 @@
+package immutable
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+var _ sql.Scanner = (*Option[any])(nil)
+
+// Scan implements the sql.Scanner interface.
+//
+// A NULL source will result in an `Option` with no value. If `*T` implements the
+// sql.Scanner interface, scanning will be delegated to it, otherwise the common driver
+// types will be converted to `T` where possible.
+func (o *Option[T]) Scan(src any) error {
+	if src == nil {
+		*o = None[T]()
+		return nil
+	}
+
+	var value T
+	if scanner, ok := any(&value).(sql.Scanner); ok {
+		err := scanner.Scan(src)
+		if err != nil {
+			return err
+		}
+		*o = Some(value)
+		return nil
+	}
+
+	err := convertAssign(&value, src)
+	if err != nil {
+		return err
+	}
+	*o = Some(value)
+	return nil
+}
+
+// Valuer returns a driver.Valuer for this `Option`, allowing it to be passed as
+// an argument to database/sql.
+//
+// An `Option` with no value will be written as NULL. If `T` implements the driver.Valuer
+// interface the value will be delegated to it, otherwise the value will be converted
+// to a driver.Value using the driver.DefaultParameterConverter.
+//
+// Option cannot implement driver.Valuer directly as its `Value` function returns the
+// value held by the `Option`.
+func (o Option[T]) Valuer() driver.Valuer {
+	return optionValuer[T]{
+		option: o,
+	}
+}
+
+type optionValuer[T any] struct {
+	option Option[T]
+}
+
+var _ driver.Valuer = optionValuer[any]{}
+
+func (v optionValuer[T]) Value() (driver.Value, error) {
+	if !v.option.HasValue() {
+		return nil, nil
+	}
+	value := v.option.Value()
+	if valuer, ok := any(value).(driver.Valuer); ok {
+		return valuer.Value()
+	}
+	return driver.DefaultParameterConverter.ConvertValue(value)
+}
+
+// convertAssign copies the given driver value into dest, converting it where
+// required.
+func convertAssign(dest any, src any) error {
+	destValue := reflect.ValueOf(dest).Elem()
+	srcValue := reflect.ValueOf(src)
+
+	if srcValue.Type().AssignableTo(destValue.Type()) {
+		switch v := src.(type) {
+		case []byte:
+			// Drivers may reuse the src buffer after Scan returns, so it must be copied.
+			destValue.Set(reflect.ValueOf(append([]byte(nil), v...)))
+		default:
+			destValue.Set(srcValue)
+		}
+		return nil
+	}
+
+	// Everything but strings and byte slices is converted via its string representation
+	// as that is what database/sql does too, and it avoids silently losing precision.
+	var s string
+	switch v := src.(type) {
+	case string:
+		s = v
+	case []byte:
+		s = string(v)
+	case time.Time:
+		s = v.Format(time.RFC3339Nano)
+	case bool:
+		s = strconv.FormatBool(v)
+	case int64:
+		s = strconv.FormatInt(v, 10)
+	case float64:
+		s = strconv.FormatFloat(v, 'g', -1, 64)
+	default:
+		return fmt.Errorf("unsupported Scan, storing driver.Value type %T into type %s", src, destValue.Type())
+	}
+
+	switch destValue.Kind() {
+	case reflect.String:
+		destValue.SetString(s)
+		return nil
+
+	case reflect.Slice:
+		if destValue.Type().Elem().Kind() == reflect.Uint8 {
+			destValue.SetBytes([]byte(s))
+			return nil
+		}
+
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return fmt.Errorf("converting driver.Value type %T (%q) to a %s: %w", src, s, destValue.Kind(), err)
+		}
+		destValue.SetBool(b)
+		return nil
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, destValue.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("converting driver.Value type %T (%q) to a %s: %w", src, s, destValue.Kind(), err)
+		}
+		destValue.SetInt(i)
+		return nil
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, destValue.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("converting driver.Value type %T (%q) to a %s: %w", src, s, destValue.Kind(), err)
+		}
+		destValue.SetUint(u)
+		return nil
+
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, destValue.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("converting driver.Value type %T (%q) to a %s: %w", src, s, destValue.Kind(), err)
+		}
+		destValue.SetFloat(f)
+		return nil
+	}
+
+	return fmt.Errorf("unsupported Scan, storing driver.Value type %T into type %s", src, destValue.Type())
+}
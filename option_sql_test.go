@@ -0,0 +1,276 @@
+package immutable
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeDriver is a minimal in-memory database/sql driver.
+//
+// Each data source name maps to a single table with a single column. Statements
+// beginning with INSERT append their first argument to the table, statements
+// beginning with SELECT yield all the values in the table.
+type fakeDriver struct {
+	mutex  sync.Mutex
+	tables map[string]*[]driver.Value
+}
+
+var testDriver = &fakeDriver{
+	tables: map[string]*[]driver.Value{},
+}
+
+func init() {
+	sql.Register("immutable-fake", testDriver)
+}
+
+func (d *fakeDriver) Open(name string) (driver.Conn, error) {
+	d.mutex.Lock()
+	defer d.mutex.Unlock()
+	table, ok := d.tables[name]
+	if !ok {
+		table = &[]driver.Value{}
+		d.tables[name] = table
+	}
+	return &fakeConn{driver: d, table: table}, nil
+}
+
+type fakeConn struct {
+	driver *fakeDriver
+	table  *[]driver.Value
+}
+
+func (c *fakeConn) Prepare(query string) (driver.Stmt, error) {
+	return &fakeStmt{conn: c, query: query}, nil
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return nil, errors.New("transactions are not supported")
+}
+
+type fakeStmt struct {
+	conn  *fakeConn
+	query string
+}
+
+func (s *fakeStmt) Close() error {
+	return nil
+}
+
+func (s *fakeStmt) NumInput() int {
+	return -1
+}
+
+func (s *fakeStmt) Exec(args []driver.Value) (driver.Result, error) {
+	if !strings.HasPrefix(s.query, "INSERT") || len(args) != 1 {
+		return nil, errors.New("unsupported statement")
+	}
+	s.conn.driver.mutex.Lock()
+	defer s.conn.driver.mutex.Unlock()
+	*s.conn.table = append(*s.conn.table, args[0])
+	return driver.RowsAffected(1), nil
+}
+
+func (s *fakeStmt) Query(args []driver.Value) (driver.Rows, error) {
+	if !strings.HasPrefix(s.query, "SELECT") {
+		return nil, errors.New("unsupported statement")
+	}
+	s.conn.driver.mutex.Lock()
+	defer s.conn.driver.mutex.Unlock()
+	values := make([]driver.Value, len(*s.conn.table))
+	copy(values, *s.conn.table)
+	return &fakeRows{values: values}, nil
+}
+
+type fakeRows struct {
+	values []driver.Value
+	index  int
+}
+
+func (r *fakeRows) Columns() []string {
+	return []string{"value"}
+}
+
+func (r *fakeRows) Close() error {
+	return nil
+}
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.index >= len(r.values) {
+		return io.EOF
+	}
+	dest[0] = r.values[r.index]
+	r.index++
+	return nil
+}
+
+// celsius is a type that implements both sql.Scanner and driver.Valuer.
+type celsius struct {
+	degrees float64
+}
+
+func (c *celsius) Scan(src any) error {
+	s, ok := src.(string)
+	if !ok {
+		return errors.New("expected string")
+	}
+	var o Option[float64]
+	err := o.Scan(strings.TrimSuffix(s, "C"))
+	if err != nil {
+		return err
+	}
+	c.degrees = o.Value()
+	return nil
+}
+
+func (c celsius) Value() (driver.Value, error) {
+	var o Option[string]
+	err := o.Scan(c.degrees)
+	if err != nil {
+		return nil, err
+	}
+	return o.Value() + "C", nil
+}
+
+func openTestDB(t *testing.T) *sql.DB {
+	db, err := sql.Open("immutable-fake", t.Name())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	t.Cleanup(func() { db.Close() })
+	return db
+}
+
+func roundTrip[T any](t *testing.T, values ...Option[T]) []Option[T] {
+	db := openTestDB(t)
+
+	for _, value := range values {
+		_, err := db.Exec("INSERT", value.Valuer())
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+	}
+
+	rows, err := db.Query("SELECT")
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	defer rows.Close()
+
+	results := []Option[T]{}
+	for rows.Next() {
+		var result Option[T]
+		err = rows.Scan(&result)
+		if err != nil {
+			t.Fatalf("expected no error, got %v", err)
+		}
+		results = append(results, result)
+	}
+	if err := rows.Err(); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	return results
+}
+
+func TestOptionSQLRoundTrip(t *testing.T) {
+	results := roundTrip(t, Some("a"), None[string](), Some(""))
+
+	if len(results) != 3 {
+		t.Fatalf("expected 3 results, got %v", len(results))
+	}
+	if !results[0].HasValue() || results[0].Value() != "a" {
+		t.Errorf("expected Some(a), got %v", results[0])
+	}
+	if results[1].HasValue() {
+		t.Errorf("expected None, got %v", results[1])
+	}
+	if !results[2].HasValue() || results[2].Value() != "" {
+		t.Errorf("expected Some(), got %v", results[2])
+	}
+}
+
+func TestOptionSQLRoundTripInt(t *testing.T) {
+	results := roundTrip(t, Some(int32(1)), None[int32]())
+
+	if !results[0].HasValue() || results[0].Value() != 1 {
+		t.Errorf("expected Some(1), got %v", results[0])
+	}
+	if results[1].HasValue() {
+		t.Errorf("expected None, got %v", results[1])
+	}
+}
+
+func TestOptionSQLRoundTripTime(t *testing.T) {
+	now := time.Now()
+	results := roundTrip(t, Some(now))
+
+	if !results[0].HasValue() || !results[0].Value().Equal(now) {
+		t.Errorf("expected Some(%v), got %v", now, results[0])
+	}
+}
+
+func TestOptionSQLRoundTripScannerValuer(t *testing.T) {
+	results := roundTrip(t, Some(celsius{degrees: 21.5}), None[celsius]())
+
+	if !results[0].HasValue() || results[0].Value().degrees != 21.5 {
+		t.Errorf("expected Some(21.5), got %v", results[0])
+	}
+	if results[1].HasValue() {
+		t.Errorf("expected None, got %v", results[1])
+	}
+}
+
+func TestOptionScanConvertsString(t *testing.T) {
+	var opt Option[float64]
+	err := opt.Scan("1.5")
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if !opt.HasValue() || opt.Value() != 1.5 {
+		t.Errorf("expected Some(1.5), got %v", opt)
+	}
+}
+
+func TestOptionScanConvertsInt(t *testing.T) {
+	var opt Option[string]
+	err := opt.Scan(int64(1))
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if !opt.HasValue() || opt.Value() != "1" {
+		t.Errorf("expected Some(1), got %v", opt)
+	}
+}
+
+func TestOptionScanErrorsGivenInvalidConversion(t *testing.T) {
+	var opt Option[int]
+	err := opt.Scan("one")
+	if err == nil {
+		t.Errorf("expected an error")
+	}
+	if opt.HasValue() {
+		t.Errorf("expected None, got %v", opt)
+	}
+}
+
+func TestOptionScanCopiesBytes(t *testing.T) {
+	src := []byte("a")
+	var opt Option[[]byte]
+	err := opt.Scan(src)
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	src[0] = 'b'
+	if string(opt.Value()) != "a" {
+		t.Errorf("expected a, got %s", opt.Value())
+	}
+}
@@ -0,0 +1,25 @@
+package immutable
+
+// Pair represents two values of potentially different types held together.
+type Pair[A any, B any] struct {
+	first  A
+	second B
+}
+
+// NewPair returns a `Pair` containing the given values.
+func NewPair[A any, B any](first A, second B) Pair[A, B] {
+	return Pair[A, B]{
+		first:  first,
+		second: second,
+	}
+}
+
+// First returns the first value held by this Pair.
+func (p Pair[A, B]) First() A {
+	return p.first
+}
+
+// Second returns the second value held by this Pair.
+func (p Pair[A, B]) Second() B {
+	return p.second
+}
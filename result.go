@@ -0,0 +1,128 @@
+package immutable
+
+import "errors"
+
+// ErrNoValue is the error held by a failed `Result` created from an `Option` with no value,
+// when no other error is given.
+var ErrNoValue = errors.New("option has no value")
+
+// Result represents the outcome of an operation that yields either a value or an error.
+type Result[T any] struct {
+	// The Value of this Result. Should be ignored if err is not nil.
+	value T
+
+	// The error of this Result. If nil, this Result contains a value.
+	err error
+}
+
+// Ok returns a successful `Result` of type `T` with the given value.
+func Ok[T any](value T) Result[T] {
+	return Result[T]{
+		value: value,
+	}
+}
+
+// Err returns a failed `Result` of type `T` with the given error.
+//
+// The given error should not be nil, if it is the returned `Result` will be successful
+// and hold the default value of `T`.
+func Err[T any](err error) Result[T] {
+	return Result[T]{
+		err: err,
+	}
+}
+
+// ResultFrom returns a `Result` from the given value and error pair, such as those
+// returned by `Enumerable.Value`.
+//
+// If the error is not nil, the value is discarded and a failed `Result` is returned.
+func ResultFrom[T any](value T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(value)
+}
+
+// ResultFromOption returns a successful `Result` containing the value of the given `Option`.
+// If the `Option` has no value, a failed `Result` with the given error is returned, or with
+// `ErrNoValue` if the given error is nil.
+func ResultFromOption[T any](o Option[T], err error) Result[T] {
+	if o.HasValue() {
+		return Ok(o.Value())
+	}
+	if err == nil {
+		err = ErrNoValue
+	}
+	return Err[T](err)
+}
+
+// IsOk returns true if this Result contains a value.
+func (r Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if this Result contains an error.
+func (r Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Value returns the Value of this Result. Value returned is invalid if IsOk() is false
+// and should be ignored.
+func (r Result[T]) Value() T {
+	return r.value
+}
+
+// Err returns the error of this Result, or nil if it contains a value.
+func (r Result[T]) Err() error {
+	return r.err
+}
+
+// Unwrap returns the value and error of this Result as a pair. If the error is not
+// nil the default value of `T` is returned.
+func (r Result[T]) Unwrap() (T, error) {
+	if r.err != nil {
+		var zero T
+		return zero, r.err
+	}
+	return r.value, nil
+}
+
+// Option returns an `Option` containing the value of this Result. If this Result contains
+// an error the returned `Option` will have no value.
+func (r Result[T]) Option() Option[T] {
+	if r.err != nil {
+		return None[T]()
+	}
+	return Some(r.value)
+}
+
+// OrElse returns this Result if it contains a value, otherwise the result of the given
+// function is returned.
+//
+// The function is only called if this Result contains an error.
+func (r Result[T]) OrElse(f func(error) Result[T]) Result[T] {
+	if r.err == nil {
+		return r
+	}
+	return f(r.err)
+}
+
+// MapResult returns a `Result` containing the output of the given function applied to the
+// value of the given `Result`. If the given `Result` contains an error the function is not
+// called and the error is carried over to the returned `Result`.
+func MapResult[T any, U any](r Result[T], f func(T) (U, error)) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return ResultFrom(f(r.value))
+}
+
+// AndThen returns the `Result` returned by the given function when applied to the value
+// of the given `Result`. If the given `Result` contains an error the function is not
+// called and the error is carried over to the returned `Result`.
+func AndThen[T any, U any](r Result[T], f func(T) Result[U]) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return f(r.value)
+}
@@ -0,0 +1,111 @@
+package immutable
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+)
+
+func TestOk(t *testing.T) {
+	r := Ok(1)
+	if !r.IsOk() || r.IsErr() {
+		t.Errorf("expected Ok to return a successful Result")
+	}
+	value, err := r.Unwrap()
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+	if value != 1 {
+		t.Errorf("expected 1, got %v", value)
+	}
+}
+
+func TestErr(t *testing.T) {
+	expectedErr := errors.New("test error")
+	r := Err[int](expectedErr)
+	if r.IsOk() || !r.IsErr() {
+		t.Errorf("expected Err to return a failed Result")
+	}
+	_, err := r.Unwrap()
+	if !errors.Is(err, expectedErr) {
+		t.Errorf("expected %v, got %v", expectedErr, err)
+	}
+}
+
+func TestResultFromDiscardsValueGivenError(t *testing.T) {
+	expectedErr := errors.New("test error")
+	r := ResultFrom(1, expectedErr)
+	if !errors.Is(r.Err(), expectedErr) {
+		t.Errorf("expected %v, got %v", expectedErr, r.Err())
+	}
+	value, _ := r.Unwrap()
+	if value != 0 {
+		t.Errorf("expected 0, got %v", value)
+	}
+}
+
+func TestResultOptionConversion(t *testing.T) {
+	expectedErr := errors.New("test error")
+
+	if opt := Ok(1).Option(); !opt.HasValue() || opt.Value() != 1 {
+		t.Errorf("expected Some(1), got %v", opt)
+	}
+	if opt := Err[int](expectedErr).Option(); opt.HasValue() {
+		t.Errorf("expected None, got %v", opt)
+	}
+	if r := ResultFromOption(Some(1), expectedErr); !r.IsOk() || r.Value() != 1 {
+		t.Errorf("expected Ok(1), got %v", r)
+	}
+	if r := ResultFromOption(None[int](), expectedErr); !errors.Is(r.Err(), expectedErr) {
+		t.Errorf("expected %v, got %v", expectedErr, r.Err())
+	}
+	if r := ResultFromOption(None[int](), nil); !errors.Is(r.Err(), ErrNoValue) {
+		t.Errorf("expected %v, got %v", ErrNoValue, r.Err())
+	}
+}
+
+func TestResultOrElse(t *testing.T) {
+	fallback := func(err error) Result[int] { return Ok(2) }
+
+	if r := Ok(1).OrElse(fallback); r.Value() != 1 {
+		t.Errorf("expected 1, got %v", r.Value())
+	}
+	if r := Err[int](errors.New("test error")).OrElse(fallback); !r.IsOk() || r.Value() != 2 {
+		t.Errorf("expected 2, got %v", r.Value())
+	}
+}
+
+func TestMapResult(t *testing.T) {
+	r := MapResult(Ok("1"), strconv.Atoi)
+	if !r.IsOk() || r.Value() != 1 {
+		t.Errorf("expected Ok(1), got %v", r)
+	}
+
+	r = MapResult(Ok("one"), strconv.Atoi)
+	if !r.IsErr() {
+		t.Errorf("expected MapResult to return a failed Result")
+	}
+
+	expectedErr := errors.New("test error")
+	r = MapResult(Err[string](expectedErr), strconv.Atoi)
+	if !errors.Is(r.Err(), expectedErr) {
+		t.Errorf("expected %v, got %v", expectedErr, r.Err())
+	}
+}
+
+func TestAndThen(t *testing.T) {
+	expectedErr := errors.New("test error")
+	f := func(v int) Result[string] {
+		if v > 1 {
+			return Ok(strconv.Itoa(v))
+		}
+		return Err[string](expectedErr)
+	}
+
+	if r := AndThen(Ok(2), f); !r.IsOk() || r.Value() != "2" {
+		t.Errorf("expected Ok(2), got %v", r)
+	}
+	if r := AndThen(Ok(1), f); !errors.Is(r.Err(), expectedErr) {
+		t.Errorf("expected %v, got %v", expectedErr, r.Err())
+	}
+}
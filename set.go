@@ -0,0 +1,176 @@
+package immutable
+
+// Set is a persistent, unordered set of values.
+//
+// Each modification returns a new Set that shares the majority of its structure with
+// the original, which remains unchanged. It is backed by a `HashMap`, and set algebra
+// operations build their results from the larger of their inputs where possible, so
+// that the result shares its structure.
+//
+// The zero value is an empty Set.
+type Set[T comparable] struct {
+	values HashMap[T, struct{}]
+}
+
+// NewSet returns a `Set` containing the given values.
+func NewSet[T comparable](values ...T) Set[T] {
+	s := Set[T]{}
+	for _, value := range values {
+		s = s.Add(value)
+	}
+	return s
+}
+
+// SetFrom returns a `Set` containing the values yielded by the given source, such as an
+// `enumerable.Enumerable`. The source is reset on completion.
+func SetFrom[T comparable](source Enumerator[T]) (Set[T], error) {
+	s := Set[T]{}
+	for {
+		hasNext, err := source.Next()
+		if err != nil {
+			return Set[T]{}, err
+		}
+		if !hasNext {
+			break
+		}
+		value, err := source.Value()
+		if err != nil {
+			return Set[T]{}, err
+		}
+		s = s.Add(value)
+	}
+	source.Reset()
+	return s, nil
+}
+
+// Len returns the number of values in this Set.
+func (s Set[T]) Len() int {
+	return s.values.Len()
+}
+
+// Contains returns true if the given value is in this Set.
+func (s Set[T]) Contains(value T) bool {
+	return s.values.Get(value).HasValue()
+}
+
+// Add returns a new Set containing the given value.
+func (s Set[T]) Add(value T) Set[T] {
+	if s.Contains(value) {
+		return s
+	}
+	s.values = s.values.Set(value, struct{}{})
+	return s
+}
+
+// Remove returns a new Set without the given value.
+func (s Set[T]) Remove(value T) Set[T] {
+	s.values = s.values.Delete(value)
+	return s
+}
+
+// Union returns a new Set containing the values that are in either this Set or the
+// other.
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	larger, smaller := s, other
+	if smaller.Len() > larger.Len() {
+		larger, smaller = smaller, larger
+	}
+	smaller.forEach(func(value T) {
+		larger = larger.Add(value)
+	})
+	return larger
+}
+
+// Intersect returns a new Set containing the values that are in both this Set and
+// the other.
+func (s Set[T]) Intersect(other Set[T]) Set[T] {
+	larger, smaller := s, other
+	if smaller.Len() > larger.Len() {
+		larger, smaller = smaller, larger
+	}
+	result := smaller
+	smaller.forEach(func(value T) {
+		if !larger.Contains(value) {
+			result = result.Remove(value)
+		}
+	})
+	return result
+}
+
+// Difference returns a new Set containing the values that are in this Set but not
+// in the other.
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	result := s
+	if other.Len() < s.Len() {
+		other.forEach(func(value T) {
+			result = result.Remove(value)
+		})
+	} else {
+		s.forEach(func(value T) {
+			if other.Contains(value) {
+				result = result.Remove(value)
+			}
+		})
+	}
+	return result
+}
+
+// SymmetricDifference returns a new Set containing the values that are in either this
+// Set or the other, but not in both.
+func (s Set[T]) SymmetricDifference(other Set[T]) Set[T] {
+	larger, smaller := s, other
+	if smaller.Len() > larger.Len() {
+		larger, smaller = smaller, larger
+	}
+	result := larger
+	smaller.forEach(func(value T) {
+		if larger.Contains(value) {
+			result = result.Remove(value)
+		} else {
+			result = result.Add(value)
+		}
+	})
+	return result
+}
+
+// IsSubsetOf returns true if every value in this Set is also in the other.
+func (s Set[T]) IsSubsetOf(other Set[T]) bool {
+	if s.Len() > other.Len() {
+		return false
+	}
+	e := s.Enumerable()
+	for {
+		// The set enumerator never returns errors.
+		hasNext, _ := e.Next()
+		if !hasNext {
+			return true
+		}
+		value, _ := e.Value()
+		if !other.Contains(value) {
+			return false
+		}
+	}
+}
+
+// Enumerable returns an `Enumerator` that yields the values of this Set.
+//
+// The order in which the values are yielded is undefined, but will be consistent for a
+// given Set.
+func (s Set[T]) Enumerable() Enumerator[T] {
+	return &keyEnumerator[T, struct{}]{
+		source: s.values.Enumerable(),
+	}
+}
+
+func (s Set[T]) forEach(action func(T)) {
+	e := s.Enumerable()
+	for {
+		// The set enumerator never returns errors.
+		hasNext, _ := e.Next()
+		if !hasNext {
+			return
+		}
+		value, _ := e.Value()
+		action(value)
+	}
+}
@@ -0,0 +1,89 @@
+package immutable
+
+import (
+	"errors"
+	"sort"
+	"testing"
+)
+
+func requireSetEquals(t *testing.T, expected []int, s Set[int]) {
+	t.Helper()
+	values := enumeratorValues(s.Enumerable())
+	sort.Ints(values)
+	requireIntsEqual(t, expected, values)
+	if s.Len() != len(expected) {
+		t.Fatalf("expected length %v, got %v", len(expected), s.Len())
+	}
+}
+
+type errorEnumerator struct {
+	err error
+}
+
+func (e errorEnumerator) Next() (bool, error) {
+	return false, e.err
+}
+
+func (e errorEnumerator) Value() (int, error) {
+	return 0, nil
+}
+
+func (e errorEnumerator) Reset() {}
+
+func TestSetAddAndRemove(t *testing.T) {
+	s1 := NewSet(1, 2, 2, 3)
+	s2 := s1.Add(4).Remove(1)
+
+	requireSetEquals(t, []int{1, 2, 3}, s1)
+	requireSetEquals(t, []int{2, 3, 4}, s2)
+	if !s2.Contains(4) || s2.Contains(1) {
+		t.Errorf("expected set to contain 4 and not 1")
+	}
+}
+
+func TestSetUnion(t *testing.T) {
+	requireSetEquals(t, []int{1, 2, 3, 4}, NewSet(1, 2).Union(NewSet(2, 3, 4)))
+	requireSetEquals(t, []int{1, 2}, NewSet(1, 2).Union(Set[int]{}))
+}
+
+func TestSetIntersect(t *testing.T) {
+	requireSetEquals(t, []int{2, 3}, NewSet(1, 2, 3).Intersect(NewSet(2, 3, 4, 5)))
+	requireSetEquals(t, []int{}, NewSet(1, 2).Intersect(NewSet(3)))
+}
+
+func TestSetDifference(t *testing.T) {
+	requireSetEquals(t, []int{1}, NewSet(1, 2, 3).Difference(NewSet(2, 3, 4, 5)))
+	requireSetEquals(t, []int{1, 3}, NewSet(1, 2, 3).Difference(NewSet(2)))
+}
+
+func TestSetSymmetricDifference(t *testing.T) {
+	requireSetEquals(t, []int{1, 4, 5}, NewSet(1, 2, 3).SymmetricDifference(NewSet(2, 3, 4, 5)))
+}
+
+func TestSetIsSubsetOf(t *testing.T) {
+	if !NewSet(1, 2).IsSubsetOf(NewSet(1, 2, 3)) {
+		t.Errorf("expected set to be a subset")
+	}
+	if NewSet(1, 4).IsSubsetOf(NewSet(1, 2, 3)) {
+		t.Errorf("expected set not to be a subset")
+	}
+	if !(Set[int]{}).IsSubsetOf(Set[int]{}) {
+		t.Errorf("expected empty set to be a subset of itself")
+	}
+}
+
+func TestSetFrom(t *testing.T) {
+	s, err := SetFrom(NewList(1, 2, 2, 3).Enumerable())
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	requireSetEquals(t, []int{1, 2, 3}, s)
+}
+
+func TestSetFromReturnsError(t *testing.T) {
+	expectedErr := errors.New("test error")
+	_, err := SetFrom[int](errorEnumerator{err: expectedErr})
+	if !errors.Is(err, expectedErr) {
+		t.Errorf("expected %v, got %v", expectedErr, err)
+	}
+}
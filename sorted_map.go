@@ -0,0 +1,416 @@
+package immutable
+
+// SortedMap is a persistent mapping of keys to values, ordered by key.
+//
+// Each modification returns a new SortedMap that shares the majority of its structure
+// with the original, which remains unchanged. It is implemented as an AVL tree, providing
+// O(log n) lookups, modifications and range queries.
+//
+// The zero value is an empty SortedMap that uses the `DefaultComparer`.
+type SortedMap[K any, V any] struct {
+	root     *sortedMapNode[K, V]
+	size     int
+	comparer Comparer[K]
+}
+
+type sortedMapNode[K any, V any] struct {
+	key    K
+	value  V
+	left   *sortedMapNode[K, V]
+	right  *sortedMapNode[K, V]
+	height int
+}
+
+// NewSortedMap returns an empty `SortedMap` that uses the given comparer, or the
+// `DefaultComparer` if the given comparer is nil.
+func NewSortedMap[K any, V any](comparer Comparer[K]) SortedMap[K, V] {
+	return SortedMap[K, V]{
+		comparer: comparer,
+	}
+}
+
+// Len returns the number of key-value pairs in this SortedMap.
+func (m SortedMap[K, V]) Len() int {
+	return m.size
+}
+
+// Get returns an `Option` containing the value for the given key. If the key is not
+// in this SortedMap the returned `Option` will have no value.
+func (m SortedMap[K, V]) Get(key K) Option[V] {
+	comparer := m.getComparer()
+	node := m.root
+	for node != nil {
+		c := comparer.Compare(key, node.key)
+		switch {
+		case c < 0:
+			node = node.left
+		case c > 0:
+			node = node.right
+		default:
+			return Some(node.value)
+		}
+	}
+	return None[V]()
+}
+
+// Set returns a new SortedMap with the given key set to the given value.
+func (m SortedMap[K, V]) Set(key K, value V) SortedMap[K, V] {
+	var added bool
+	m.root, added = m.root.set(m.getComparer(), key, value)
+	if added {
+		m.size++
+	}
+	return m
+}
+
+// Delete returns a new SortedMap without the given key. If the key is not in this
+// SortedMap, this SortedMap is returned.
+func (m SortedMap[K, V]) Delete(key K) SortedMap[K, V] {
+	root, removed := m.root.delete(m.getComparer(), key)
+	if !removed {
+		return m
+	}
+	m.root = root
+	m.size--
+	return m
+}
+
+// Min returns an `Option` containing the key-value pair with the smallest key, or
+// no value if this SortedMap is empty.
+func (m SortedMap[K, V]) Min() Option[Pair[K, V]] {
+	if m.root == nil {
+		return None[Pair[K, V]]()
+	}
+	node := m.root
+	for node.left != nil {
+		node = node.left
+	}
+	return Some(NewPair(node.key, node.value))
+}
+
+// Max returns an `Option` containing the key-value pair with the largest key, or
+// no value if this SortedMap is empty.
+func (m SortedMap[K, V]) Max() Option[Pair[K, V]] {
+	if m.root == nil {
+		return None[Pair[K, V]]()
+	}
+	node := m.root
+	for node.right != nil {
+		node = node.right
+	}
+	return Some(NewPair(node.key, node.value))
+}
+
+// Floor returns an `Option` containing the key-value pair with the largest key less
+// than or equal to the given key, or no value if there is no such key.
+func (m SortedMap[K, V]) Floor(key K) Option[Pair[K, V]] {
+	comparer := m.getComparer()
+	var result *sortedMapNode[K, V]
+	node := m.root
+	for node != nil {
+		c := comparer.Compare(key, node.key)
+		switch {
+		case c < 0:
+			node = node.left
+		case c > 0:
+			result = node
+			node = node.right
+		default:
+			return Some(NewPair(node.key, node.value))
+		}
+	}
+	if result == nil {
+		return None[Pair[K, V]]()
+	}
+	return Some(NewPair(result.key, result.value))
+}
+
+// Ceiling returns an `Option` containing the key-value pair with the smallest key greater
+// than or equal to the given key, or no value if there is no such key.
+func (m SortedMap[K, V]) Ceiling(key K) Option[Pair[K, V]] {
+	comparer := m.getComparer()
+	var result *sortedMapNode[K, V]
+	node := m.root
+	for node != nil {
+		c := comparer.Compare(key, node.key)
+		switch {
+		case c < 0:
+			result = node
+			node = node.left
+		case c > 0:
+			node = node.right
+		default:
+			return Some(NewPair(node.key, node.value))
+		}
+	}
+	if result == nil {
+		return None[Pair[K, V]]()
+	}
+	return Some(NewPair(result.key, result.value))
+}
+
+// Enumerable returns an `Enumerator` that yields the key-value pairs of this SortedMap
+// in ascending key order.
+func (m SortedMap[K, V]) Enumerable() Enumerator[Pair[K, V]] {
+	return m.enumerate(None[K](), None[K](), false)
+}
+
+// Reverse returns an `Enumerator` that yields the key-value pairs of this SortedMap
+// in descending key order.
+func (m SortedMap[K, V]) Reverse() Enumerator[Pair[K, V]] {
+	return m.enumerate(None[K](), None[K](), true)
+}
+
+// Range returns an `Enumerator` that yields the key-value pairs of this SortedMap with
+// keys greater than or equal to lo and less than hi, in ascending key order.
+func (m SortedMap[K, V]) Range(lo K, hi K) Enumerator[Pair[K, V]] {
+	return m.enumerate(Some(lo), Some(hi), false)
+}
+
+// ReverseRange returns an `Enumerator` that yields the key-value pairs of this SortedMap
+// with keys greater than or equal to lo and less than hi, in descending key order.
+func (m SortedMap[K, V]) ReverseRange(lo K, hi K) Enumerator[Pair[K, V]] {
+	return m.enumerate(Some(lo), Some(hi), true)
+}
+
+func (m SortedMap[K, V]) enumerate(lo Option[K], hi Option[K], reverse bool) *sortedMapEnumerator[K, V] {
+	return &sortedMapEnumerator[K, V]{
+		root:     m.root,
+		comparer: m.getComparer(),
+		lo:       lo,
+		hi:       hi,
+		reverse:  reverse,
+	}
+}
+
+func (m SortedMap[K, V]) getComparer() Comparer[K] {
+	if m.comparer == nil {
+		return defaultComparer[K]{}
+	}
+	return m.comparer
+}
+
+func sortedMapNodeHeight[K any, V any](n *sortedMapNode[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+func newSortedMapNode[K any, V any](
+	key K,
+	value V,
+	left *sortedMapNode[K, V],
+	right *sortedMapNode[K, V],
+) *sortedMapNode[K, V] {
+	height := sortedMapNodeHeight(left)
+	if rightHeight := sortedMapNodeHeight(right); rightHeight > height {
+		height = rightHeight
+	}
+	return &sortedMapNode[K, V]{
+		key:    key,
+		value:  value,
+		left:   left,
+		right:  right,
+		height: height + 1,
+	}
+}
+
+// balanceSortedMapNode returns a new node with the given contents, rotating it if the
+// heights of the given children differ by more than one.
+//
+// Existing nodes are never modified, rotated nodes are replaced by new ones.
+func balanceSortedMapNode[K any, V any](
+	key K,
+	value V,
+	left *sortedMapNode[K, V],
+	right *sortedMapNode[K, V],
+) *sortedMapNode[K, V] {
+	leftHeight := sortedMapNodeHeight(left)
+	rightHeight := sortedMapNodeHeight(right)
+
+	if leftHeight > rightHeight+1 {
+		if sortedMapNodeHeight(left.left) >= sortedMapNodeHeight(left.right) {
+			return newSortedMapNode(
+				left.key,
+				left.value,
+				left.left,
+				newSortedMapNode(key, value, left.right, right),
+			)
+		}
+		return newSortedMapNode(
+			left.right.key,
+			left.right.value,
+			newSortedMapNode(left.key, left.value, left.left, left.right.left),
+			newSortedMapNode(key, value, left.right.right, right),
+		)
+	}
+
+	if rightHeight > leftHeight+1 {
+		if sortedMapNodeHeight(right.right) >= sortedMapNodeHeight(right.left) {
+			return newSortedMapNode(
+				right.key,
+				right.value,
+				newSortedMapNode(key, value, left, right.left),
+				right.right,
+			)
+		}
+		return newSortedMapNode(
+			right.left.key,
+			right.left.value,
+			newSortedMapNode(key, value, left, right.left.left),
+			newSortedMapNode(right.key, right.value, right.left.right, right.right),
+		)
+	}
+
+	return newSortedMapNode(key, value, left, right)
+}
+
+// set returns a copy of this subtree with the given key set to the given value, along
+// with true if the key was not previously present. This node may be nil.
+func (n *sortedMapNode[K, V]) set(comparer Comparer[K], key K, value V) (*sortedMapNode[K, V], bool) {
+	if n == nil {
+		return newSortedMapNode[K, V](key, value, nil, nil), true
+	}
+
+	c := comparer.Compare(key, n.key)
+	switch {
+	case c < 0:
+		left, added := n.left.set(comparer, key, value)
+		return balanceSortedMapNode(n.key, n.value, left, n.right), added
+	case c > 0:
+		right, added := n.right.set(comparer, key, value)
+		return balanceSortedMapNode(n.key, n.value, n.left, right), added
+	default:
+		return newSortedMapNode(key, value, n.left, n.right), false
+	}
+}
+
+// delete returns a copy of this subtree without the given key, along with true if the key
+// was present. If the key was not present this node is returned. This node may be nil.
+func (n *sortedMapNode[K, V]) delete(comparer Comparer[K], key K) (*sortedMapNode[K, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	c := comparer.Compare(key, n.key)
+	switch {
+	case c < 0:
+		left, removed := n.left.delete(comparer, key)
+		if !removed {
+			return n, false
+		}
+		return balanceSortedMapNode(n.key, n.value, left, n.right), true
+
+	case c > 0:
+		right, removed := n.right.delete(comparer, key)
+		if !removed {
+			return n, false
+		}
+		return balanceSortedMapNode(n.key, n.value, n.left, right), true
+
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+		// Replace this node with its successor.
+		successor := n.right
+		for successor.left != nil {
+			successor = successor.left
+		}
+		return balanceSortedMapNode(successor.key, successor.value, n.left, n.right.deleteMin()), true
+	}
+}
+
+// deleteMin returns a copy of this subtree without its smallest key.
+func (n *sortedMapNode[K, V]) deleteMin() *sortedMapNode[K, V] {
+	if n.left == nil {
+		return n.right
+	}
+	return balanceSortedMapNode(n.key, n.value, n.left.deleteMin(), n.right)
+}
+
+type sortedMapEnumerator[K any, V any] struct {
+	root     *sortedMapNode[K, V]
+	comparer Comparer[K]
+
+	// The inclusive lower bound of the keys to yield.
+	lo Option[K]
+
+	// The exclusive upper bound of the keys to yield.
+	hi Option[K]
+
+	// If true, keys are yielded in descending order.
+	reverse bool
+
+	// The nodes yet to be yielded on the path to the next node.
+	stack []*sortedMapNode[K, V]
+
+	started      bool
+	currentValue Pair[K, V]
+}
+
+func (e *sortedMapEnumerator[K, V]) Next() (bool, error) {
+	if !e.started {
+		e.started = true
+		e.pushPath(e.root)
+	}
+
+	if len(e.stack) == 0 {
+		return false, nil
+	}
+
+	node := e.stack[len(e.stack)-1]
+	e.stack = e.stack[:len(e.stack)-1]
+
+	if e.reverse {
+		if e.lo.HasValue() && e.comparer.Compare(node.key, e.lo.Value()) < 0 {
+			e.stack = e.stack[:0]
+			return false, nil
+		}
+		e.pushPath(node.left)
+	} else {
+		if e.hi.HasValue() && e.comparer.Compare(node.key, e.hi.Value()) >= 0 {
+			e.stack = e.stack[:0]
+			return false, nil
+		}
+		e.pushPath(node.right)
+	}
+
+	e.currentValue = NewPair(node.key, node.value)
+	return true, nil
+}
+
+// pushPath pushes the nodes on the path from the given node to the first node of its
+// subtree to be yielded, skipping nodes that fall before the start of the range.
+func (e *sortedMapEnumerator[K, V]) pushPath(node *sortedMapNode[K, V]) {
+	for node != nil {
+		if e.reverse {
+			if e.hi.HasValue() && e.comparer.Compare(node.key, e.hi.Value()) >= 0 {
+				node = node.left
+				continue
+			}
+			e.stack = append(e.stack, node)
+			node = node.right
+		} else {
+			if e.lo.HasValue() && e.comparer.Compare(node.key, e.lo.Value()) < 0 {
+				node = node.right
+				continue
+			}
+			e.stack = append(e.stack, node)
+			node = node.left
+		}
+	}
+}
+
+func (e *sortedMapEnumerator[K, V]) Value() (Pair[K, V], error) {
+	return e.currentValue, nil
+}
+
+func (e *sortedMapEnumerator[K, V]) Reset() {
+	e.stack = e.stack[:0]
+	e.started = false
+}
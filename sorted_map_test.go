@@ -0,0 +1,190 @@
+package immutable
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+)
+
+func enumeratorValues[T any](e Enumerator[T]) []T {
+	values := []T{}
+	for {
+		hasNext, _ := e.Next()
+		if !hasNext {
+			break
+		}
+		value, _ := e.Value()
+		values = append(values, value)
+	}
+	return values
+}
+
+func sortedMapKeys[K any, V any](e Enumerator[Pair[K, V]]) []K {
+	keys := []K{}
+	for _, pair := range enumeratorValues(e) {
+		keys = append(keys, pair.First())
+	}
+	return keys
+}
+
+func requireIntsEqual(t *testing.T, expected []int, actual []int) {
+	t.Helper()
+	if len(expected) != len(actual) {
+		t.Fatalf("expected %v, got %v", expected, actual)
+	}
+	for i := range expected {
+		if expected[i] != actual[i] {
+			t.Fatalf("expected %v, got %v", expected, actual)
+		}
+	}
+}
+
+// checkSortedMapBalance returns the height of the given subtree, failing if it is
+// not balanced.
+func checkSortedMapBalance[K any, V any](t *testing.T, n *sortedMapNode[K, V]) int {
+	if n == nil {
+		return 0
+	}
+	left := checkSortedMapBalance(t, n.left)
+	right := checkSortedMapBalance(t, n.right)
+	if left-right > 1 || right-left > 1 {
+		t.Fatalf("expected tree to be balanced, got heights %v and %v", left, right)
+	}
+	expectedHeight := left + 1
+	if right > left {
+		expectedHeight = right + 1
+	}
+	if n.height != expectedHeight {
+		t.Fatalf("expected height %v, got %v", expectedHeight, n.height)
+	}
+	return n.height
+}
+
+func TestSortedMapSetAndDelete(t *testing.T) {
+	r := rand.New(rand.NewSource(0))
+	expected := map[int]int{}
+	m := SortedMap[int, int]{}
+	for i := 0; i < 5_000; i++ {
+		key := r.Intn(2_000)
+		if r.Intn(3) == 0 {
+			m = m.Delete(key)
+			delete(expected, key)
+		} else {
+			m = m.Set(key, i)
+			expected[key] = i
+		}
+	}
+	checkSortedMapBalance(t, m.root)
+
+	if m.Len() != len(expected) {
+		t.Fatalf("expected length %v, got %v", len(expected), m.Len())
+	}
+	expectedKeys := []int{}
+	for k, v := range expected {
+		expectedKeys = append(expectedKeys, k)
+		if value := m.Get(k); !value.HasValue() || value.Value() != v {
+			t.Fatalf("expected %v for key %v, got %v", v, k, value)
+		}
+	}
+	sort.Ints(expectedKeys)
+	requireIntsEqual(t, expectedKeys, sortedMapKeys(m.Enumerable()))
+}
+
+func TestSortedMapModificationsDoNotAffectPreviousVersions(t *testing.T) {
+	m1 := SortedMap[int, string]{}.Set(1, "a").Set(2, "b")
+	m2 := m1.Set(1, "c")
+	m3 := m1.Delete(2)
+
+	if m1.Get(1).Value() != "a" || m1.Len() != 2 {
+		t.Errorf("expected m1 to be unchanged")
+	}
+	if m2.Get(1).Value() != "c" {
+		t.Errorf("expected c, got %v", m2.Get(1).Value())
+	}
+	if m3.Get(2).HasValue() || m3.Len() != 1 {
+		t.Errorf("expected 2 to be deleted from m3")
+	}
+}
+
+func TestSortedMapMinMaxFloorCeiling(t *testing.T) {
+	m := SortedMap[int, int]{}
+	if m.Min().HasValue() || m.Max().HasValue() {
+		t.Errorf("expected Min and Max to return no value given empty")
+	}
+
+	for _, k := range []int{10, 20, 30} {
+		m = m.Set(k, k)
+	}
+
+	if v := m.Min().Value().First(); v != 10 {
+		t.Errorf("expected 10, got %v", v)
+	}
+	if v := m.Max().Value().First(); v != 30 {
+		t.Errorf("expected 30, got %v", v)
+	}
+	if v := m.Floor(25).Value().First(); v != 20 {
+		t.Errorf("expected 20, got %v", v)
+	}
+	if v := m.Floor(20).Value().First(); v != 20 {
+		t.Errorf("expected 20, got %v", v)
+	}
+	if m.Floor(5).HasValue() {
+		t.Errorf("expected Floor to return no value")
+	}
+	if v := m.Ceiling(25).Value().First(); v != 30 {
+		t.Errorf("expected 30, got %v", v)
+	}
+	if m.Ceiling(35).HasValue() {
+		t.Errorf("expected Ceiling to return no value")
+	}
+}
+
+func TestSortedMapRange(t *testing.T) {
+	m := SortedMap[int, int]{}
+	for i := 0; i < 100; i += 2 {
+		m = m.Set(i, i)
+	}
+
+	requireIntsEqual(t, []int{10, 12, 14}, sortedMapKeys(m.Range(10, 16)))
+	requireIntsEqual(t, []int{10, 12, 14}, sortedMapKeys(m.Range(9, 15)))
+	requireIntsEqual(t, []int{14, 12, 10}, sortedMapKeys(m.ReverseRange(9, 15)))
+	requireIntsEqual(t, []int{}, sortedMapKeys(m.Range(200, 300)))
+	requireIntsEqual(t, []int{98, 96, 94}, sortedMapKeys(m.Reverse())[:3])
+}
+
+func TestSortedMapEnumerableResets(t *testing.T) {
+	m := SortedMap[int, int]{}.Set(1, 1).Set(2, 2)
+	e := m.Range(0, 5)
+	e.Next()
+	e.Reset()
+	requireIntsEqual(t, []int{1, 2}, sortedMapKeys(e))
+}
+
+func TestSortedMapWithComparer(t *testing.T) {
+	descending := ComparerFunc[int](func(a int, b int) int { return b - a })
+	m := NewSortedMap[int, int](descending).Set(1, 1).Set(3, 3).Set(2, 2)
+	requireIntsEqual(t, []int{3, 2, 1}, sortedMapKeys(m.Enumerable()))
+}
+
+func TestSortedSet(t *testing.T) {
+	s := NewSortedSet[int](nil, 5, 1, 3, 3)
+	if s.Len() != 3 {
+		t.Errorf("expected length 3, got %v", s.Len())
+	}
+	if !s.Contains(3) || s.Contains(2) {
+		t.Errorf("expected set to contain 3 and not 2")
+	}
+
+	s2 := s.Remove(3).Add(4)
+	requireIntsEqual(t, []int{1, 3, 5}, enumeratorValues(s.Enumerable()))
+	requireIntsEqual(t, []int{1, 4, 5}, enumeratorValues(s2.Enumerable()))
+	requireIntsEqual(t, []int{5, 4, 1}, enumeratorValues(s2.Reverse()))
+	requireIntsEqual(t, []int{4}, enumeratorValues(s2.Range(2, 5)))
+
+	if s2.Min().Value() != 1 || s2.Max().Value() != 5 {
+		t.Errorf("expected min 1 and max 5")
+	}
+	if s2.Floor(3).Value() != 1 || s2.Ceiling(3).Value() != 4 {
+		t.Errorf("expected floor 1 and ceiling 4")
+	}
+}
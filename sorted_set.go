@@ -0,0 +1,105 @@
+package immutable
+
+// SortedSet is a persistent, ordered set of values.
+//
+// Each modification returns a new SortedSet that shares the majority of its structure
+// with the original, which remains unchanged. It is backed by a `SortedMap`.
+//
+// The zero value is an empty SortedSet that uses the `DefaultComparer`.
+type SortedSet[T any] struct {
+	values SortedMap[T, struct{}]
+}
+
+// NewSortedSet returns a `SortedSet` containing the given values, ordered by the given
+// comparer, or the `DefaultComparer` if the given comparer is nil.
+func NewSortedSet[T any](comparer Comparer[T], values ...T) SortedSet[T] {
+	s := SortedSet[T]{
+		values: NewSortedMap[T, struct{}](comparer),
+	}
+	for _, value := range values {
+		s = s.Add(value)
+	}
+	return s
+}
+
+// Len returns the number of values in this SortedSet.
+func (s SortedSet[T]) Len() int {
+	return s.values.Len()
+}
+
+// Contains returns true if the given value is in this SortedSet.
+func (s SortedSet[T]) Contains(value T) bool {
+	return s.values.Get(value).HasValue()
+}
+
+// Add returns a new SortedSet containing the given value.
+func (s SortedSet[T]) Add(value T) SortedSet[T] {
+	s.values = s.values.Set(value, struct{}{})
+	return s
+}
+
+// Remove returns a new SortedSet without the given value.
+func (s SortedSet[T]) Remove(value T) SortedSet[T] {
+	s.values = s.values.Delete(value)
+	return s
+}
+
+// Min returns an `Option` containing the smallest value, or no value if this SortedSet
+// is empty.
+func (s SortedSet[T]) Min() Option[T] {
+	return sortedSetKey(s.values.Min())
+}
+
+// Max returns an `Option` containing the largest value, or no value if this SortedSet
+// is empty.
+func (s SortedSet[T]) Max() Option[T] {
+	return sortedSetKey(s.values.Max())
+}
+
+// Floor returns an `Option` containing the largest value less than or equal to the given
+// value, or no value if there is no such value.
+func (s SortedSet[T]) Floor(value T) Option[T] {
+	return sortedSetKey(s.values.Floor(value))
+}
+
+// Ceiling returns an `Option` containing the smallest value greater than or equal to the
+// given value, or no value if there is no such value.
+func (s SortedSet[T]) Ceiling(value T) Option[T] {
+	return sortedSetKey(s.values.Ceiling(value))
+}
+
+// Enumerable returns an `Enumerator` that yields the values of this SortedSet in
+// ascending order.
+func (s SortedSet[T]) Enumerable() Enumerator[T] {
+	return &keyEnumerator[T, struct{}]{
+		source: s.values.Enumerable(),
+	}
+}
+
+// Reverse returns an `Enumerator` that yields the values of this SortedSet in descending
+// order.
+func (s SortedSet[T]) Reverse() Enumerator[T] {
+	return &keyEnumerator[T, struct{}]{
+		source: s.values.Reverse(),
+	}
+}
+
+// Range returns an `Enumerator` that yields the values of this SortedSet greater than
+// or equal to lo and less than hi, in ascending order.
+func (s SortedSet[T]) Range(lo T, hi T) Enumerator[T] {
+	return &keyEnumerator[T, struct{}]{
+		source: s.values.Range(lo, hi),
+	}
+}
+
+// ReverseRange returns an `Enumerator` that yields the values of this SortedSet greater
+// than or equal to lo and less than hi, in descending order.
+func (s SortedSet[T]) ReverseRange(lo T, hi T) Enumerator[T] {
+	return &keyEnumerator[T, struct{}]{
+		source: s.values.ReverseRange(lo, hi),
+	}
+}
+
+func sortedSetKey[T any](o Option[Pair[T, struct{}]]) Option[T] {
+	return Map(o, Pair[T, struct{}].First)
+}